@@ -0,0 +1,70 @@
+// Command coverage prints which items, traits and champion abilities the simulator models.
+//
+// Usage:
+//
+//	go run ./cmd/coverage [-data ./assets/en_us_14.5.json] [-set TFTSet14] [-json] [-all]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"tft-dps-simulator/internal/core/data"
+	"tft-dps-simulator/internal/service"
+)
+
+func main() {
+	dataFile := flag.String("data", "./assets/en_us_14.5.json", "Community Dragon set data file")
+	mutator := flag.String("set", "TFTSet14", "set mutator to load")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	showAll := flag.Bool("all", false, "also list fully modelled entries")
+	flag.Parse()
+
+	// Handler registration and data loading are chatty; keep stdout for the report.
+	log.SetOutput(io.Discard)
+	ds, err := data.LoadDataSet(*dataFile, *mutator, "")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading set data: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := service.BuildCoverageReport(ds)
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding report: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, note := range report.Notes {
+		fmt.Fprintf(w, "Note: %s\n", note)
+	}
+	fmt.Fprintln(w)
+	printSection(w, "Items", report.Items, report.Summary["items"], *showAll)
+	printSection(w, "Traits", report.Traits, report.Summary["traits"], *showAll)
+	printSection(w, "Abilities", report.Abilities, report.Summary["abilities"], *showAll)
+	w.Flush()
+}
+
+// printSection prints a summary line, then every entry that is not fully modelled (or all with -all).
+func printSection(w *tabwriter.Writer, title string, entries []service.CoverageEntry, counts map[service.CoverageStatus]int, showAll bool) {
+	fmt.Fprintf(w, "== %s: %d handler, %d static, %d generic, %d unsupported ==\n", title,
+		counts[service.CoverageHandler], counts[service.CoverageStatic], counts[service.CoverageGeneric], counts[service.CoverageUnsupported])
+	for _, entry := range entries {
+		if !showAll && (entry.Status == service.CoverageHandler || entry.Status == service.CoverageStatic) {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", entry.ApiName, entry.Status, entry.Note, entry.Unmodelled)
+	}
+	fmt.Fprintln(w)
+}
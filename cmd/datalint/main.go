@@ -0,0 +1,97 @@
+// Command datalint checks Community Dragon data files for problems that would break the simulator.
+// It exits with status 1 when any file has errors (or warnings with -strict), so it can gate a
+// data drop before deploying.
+//
+// Usage:
+//
+//	go run ./cmd/datalint [-set TFTSet14] [-json] [-strict] [./assets/en_us_14.5.json ...]
+//
+// Without file arguments every ./assets/en_us_*.json file is checked.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+// fileReport is the lint result for one file.
+type fileReport struct {
+	File   string          `json:"file"`
+	Error  string          `json:"error,omitempty"` // Set when the file could not be loaded at all
+	Report data.LintReport `json:"report"`
+}
+
+func main() {
+	mutator := flag.String("set", "TFTSet14", "set mutator to check")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	strict := flag.Bool("strict", false, "fail on warnings as well as errors")
+	flag.Parse()
+
+	files := flag.Args()
+	if len(files) == 0 {
+		var err error
+		files, err = data.FileSource{Dir: "./assets"}.Files()
+		if err != nil || len(files) == 0 {
+			fmt.Fprintln(os.Stderr, "No data files given and none found in ./assets")
+			os.Exit(1)
+		}
+	}
+
+	log.SetOutput(io.Discard)
+	failed := false
+	reports := make([]fileReport, 0, len(files))
+	for _, file := range files {
+		result := fileReport{File: file}
+		report, err := data.LintFile(file, *mutator)
+		if err != nil {
+			result.Error = err.Error()
+			failed = true
+		} else {
+			result.Report = report
+			if report.Errors() > 0 || (*strict && report.Warnings() > 0) {
+				failed = true
+			}
+		}
+		reports = append(reports, result)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(reports); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding report: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		for _, result := range reports {
+			printFile(w, result)
+		}
+		w.Flush()
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// printFile prints a summary line for one file followed by its issues.
+func printFile(w *tabwriter.Writer, result fileReport) {
+	if result.Error != "" {
+		fmt.Fprintf(w, "== %s: FAILED TO LOAD: %s ==\n\n", result.File, result.Error)
+		return
+	}
+	report := result.Report
+	fmt.Fprintf(w, "== %s (%s): %d errors, %d warnings ==\n", result.File, report.SetID, report.Errors(), report.Warnings())
+	for _, issue := range report.Issues {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", issue.Severity, issue.Kind, issue.ApiName, issue.Field, issue.Message)
+	}
+	fmt.Fprintln(w)
+}
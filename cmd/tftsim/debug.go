@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"tft-dps-simulator/internal/core/entity"
+	"tft-dps-simulator/internal/core/simulation"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+	"tft-dps-simulator/internal/service"
+)
+
+const debugHelp = `Commands:
+  s, step [N]              dispatch the next N events (default 1), stopping at breakpoints after the first
+  u, until T               dispatch events up to time T
+  c, continue              run to the next breakpoint or the end
+  b, break TYPE [ENTITY]   stop before events of TYPE (e.g. AttackLandedEvent), optionally for one entity
+  b, break ENTITY          stop before any event that refers to ENTITY
+  d, delete ID             delete a breakpoint
+  breaks                   list breakpoints
+  q, queue [N]             show the next N pending events (default 10)
+  i, inspect ENTITY        show every component of an entity
+  e, entities              list entities
+  trace on|off             show simulation logs while events are dispatched
+  results                  show results so far
+  h, help                  show this help
+  exit                     leave the debugger
+`
+
+// debugREPL steps through a scenario's simulation interactively.
+type debugREPL struct {
+	session *service.DebugSession
+	out     io.Writer
+	trace   bool
+}
+
+// runDebugger runs the interactive debugger for one scenario, reading commands from in.
+func runDebugger(simService *service.SimulationService, scenario *service.Scenario, seed int64, in io.Reader, out io.Writer) error {
+	req := scenario.RunSimulationRequest
+	if seed != 0 {
+		req.Seed = seed
+	}
+	if _, err := simService.CheckRequest(req); err != nil {
+		return err
+	}
+	session, err := simService.NewDebugSession(req)
+	if err != nil {
+		return err
+	}
+	repl := &debugREPL{session: session, out: out}
+
+	fmt.Fprintf(out, "Debugging %s (seed %d). Type help for commands.\n", scenario.Name, req.Seed)
+	repl.printNext()
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprintf(out, "(t=%.3f) > ", session.Debugger.CurrentTime())
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "exit" || fields[0] == "quit" {
+			return nil
+		}
+		if err := repl.execute(fields[0], fields[1:]); err != nil {
+			fmt.Fprintf(out, "error: %v\n", err)
+		}
+	}
+}
+
+func (r *debugREPL) execute(command string, args []string) error {
+	debugger := r.session.Debugger
+	switch command {
+	case "s", "step":
+		n, err := optionalInt(args, 1)
+		if err != nil {
+			return err
+		}
+		r.report(r.withTrace(func() simulation.Stop { return debugger.StepN(n) }))
+	case "u", "until":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: until T")
+		}
+		t, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return fmt.Errorf("invalid time %q", args[0])
+		}
+		r.report(r.withTrace(func() simulation.Stop { return debugger.RunUntil(t) }))
+	case "c", "continue":
+		r.report(r.withTrace(debugger.Continue))
+	case "b", "break":
+		return r.addBreakpoint(args)
+	case "d", "delete":
+		id, err := optionalInt(args, 0)
+		if err != nil || !debugger.RemoveBreakpoint(id) {
+			return fmt.Errorf("no breakpoint %v", strings.Join(args, " "))
+		}
+	case "breaks":
+		for _, bp := range debugger.Breakpoints() {
+			fmt.Fprintln(r.out, bp)
+		}
+	case "q", "queue":
+		n, err := optionalInt(args, 10)
+		if err != nil {
+			return err
+		}
+		pending := debugger.Pending()
+		fmt.Fprintf(r.out, "%d pending events\n", len(pending))
+		for i, item := range pending {
+			if i == n {
+				fmt.Fprintf(r.out, "  ... %d more\n", len(pending)-n)
+				break
+			}
+			fmt.Fprintf(r.out, "  %s\n", r.describe(item))
+		}
+	case "i", "inspect":
+		e, err := parseEntity(args)
+		if err != nil {
+			return err
+		}
+		r.inspect(e)
+	case "e", "entities":
+		for _, e := range debugger.World().Entities() {
+			fmt.Fprintf(r.out, "  %d %s\n", e, r.session.EntityName(e))
+		}
+	case "trace":
+		if len(args) != 1 || (args[0] != "on" && args[0] != "off") {
+			return fmt.Errorf("usage: trace on|off")
+		}
+		r.trace = args[0] == "on"
+	case "results":
+		resp := r.session.Response()
+		for _, champ := range resp.Results {
+			fmt.Fprintf(r.out, "  %d %s: %.0f damage, %d autos, %d casts\n", champ.ChampionEntityID, champ.ChampionApiName,
+				champ.DamageStats.TotalDamage, champ.DamageStats.TotalAutoAttackCounts, champ.DamageStats.TotalSpellCastCounts)
+		}
+	case "h", "help":
+		fmt.Fprint(r.out, debugHelp)
+	default:
+		return fmt.Errorf("unknown command %q (type help)", command)
+	}
+	return nil
+}
+
+// withTrace runs fn with simulation logs shown when tracing is on. They are discarded otherwise.
+func (r *debugREPL) withTrace(fn func() simulation.Stop) simulation.Stop {
+	if r.trace {
+		log.SetOutput(r.out)
+		defer log.SetOutput(io.Discard)
+	}
+	return fn()
+}
+
+func (r *debugREPL) addBreakpoint(args []string) error {
+	var eventType string
+	var e entity.Entity
+	switch len(args) {
+	case 1:
+		if parsed, err := parseEntity(args); err == nil {
+			e = parsed
+		} else {
+			eventType = args[0]
+		}
+	case 2:
+		parsed, err := parseEntity(args[1:])
+		if err != nil {
+			return err
+		}
+		eventType, e = args[0], parsed
+	default:
+		return fmt.Errorf("usage: break TYPE [ENTITY] | break ENTITY")
+	}
+	bp, err := r.session.Debugger.AddBreakpoint(eventType, e)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(r.out, "breakpoint %s\n", bp)
+	return nil
+}
+
+func (r *debugREPL) report(stop simulation.Stop) {
+	switch stop.Reason {
+	case simulation.StopBreakpoint:
+		fmt.Fprintf(r.out, "%d events, stopped at breakpoint %s\n", stop.Processed, stop.Breakpoint)
+	case simulation.StopFinished:
+		fmt.Fprintf(r.out, "%d events, simulation finished after %d events\n", stop.Processed, r.session.Debugger.EventsProcessed())
+		return
+	default:
+		fmt.Fprintf(r.out, "%d events\n", stop.Processed)
+	}
+	r.printNext()
+}
+
+func (r *debugREPL) printNext() {
+	if next := r.session.Debugger.Next(); next != nil {
+		fmt.Fprintf(r.out, "next: %s\n", r.describe(next))
+	}
+}
+
+// describe formats an event with the names of the entities it refers to.
+func (r *debugREPL) describe(item *eventsys.EventItem) string {
+	var names []string
+	for _, e := range eventsys.EventEntities(item.Event) {
+		names = append(names, fmt.Sprintf("%d:%s", e, r.session.EntityName(e)))
+	}
+	return fmt.Sprintf("t=%.3f %s [%s] %+v", item.Timestamp, eventsys.EventTypeName(item.Event), strings.Join(names, " "), item.Event)
+}
+
+func (r *debugREPL) inspect(e entity.Entity) {
+	comps := r.session.Debugger.World().Components(e)
+	if len(comps) == 0 {
+		fmt.Fprintf(r.out, "entity %d has no components\n", e)
+		return
+	}
+	names := make([]string, 0, len(comps))
+	for name := range comps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(r.out, "entity %d %s\n", e, r.session.EntityName(e))
+	for _, name := range names {
+		fmt.Fprintf(r.out, "  %s: %+v\n", name, comps[name])
+	}
+}
+
+func optionalInt(args []string, fallback int) (int, error) {
+	if len(args) == 0 {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid number %q", args[0])
+	}
+	return n, nil
+}
+
+func parseEntity(args []string) (entity.Entity, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("expected an entity ID")
+	}
+	id, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("invalid entity ID %q", args[0])
+	}
+	return entity.Entity(id), nil
+}
+
+// debugScenario loads a scenario file and runs the debugger on stdin and stdout.
+func debugScenario(simService *service.SimulationService, file string, seed int64, jitter bool) error {
+	scenario, err := service.LoadScenarioFile(file)
+	if err != nil {
+		return err
+	}
+	scenario.Jitter = scenario.Jitter || jitter
+	return runDebugger(simService, scenario, seed, os.Stdin, os.Stdout)
+}
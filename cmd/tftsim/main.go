@@ -0,0 +1,351 @@
+// Command tftsim runs scenario files through the simulation service without the HTTP server
+// and prints per-champion damage stats.
+//
+// A scenario file is a simulation request in JSON with an optional name:
+//
+//	{
+//	  "name": "kog 3 items",
+//	  "boardChampions": [{"apiName": "TFT14_KogMaw", "stars": 2, "items": [{"apiName": "TFT_Item_GuinsoosRageblade"}], "position": {"row": 0, "col": 0}}],
+//	  "enemies": [{"hp": 5000, "armor": 60, "magicResist": 60}],
+//	  "config": {"maxTime": 30},
+//	  "jitter": true,
+//	  "seed": 42
+//	}
+//
+// Usage:
+//
+//	go run ./cmd/tftsim [-format table|json|csv] [-runs N] [-jitter] [-seed S] [-archive DIR] scenario.json ...
+//	go run ./cmd/tftsim -replay archive.json ...
+//	go run ./cmd/tftsim -debug [-jitter] [-seed S] scenario.json
+//
+// Events at the same time resolve in a fixed order, so every run of a scenario is the same.
+// -jitter (or "jitter" in the scenario) shuffles them instead, for Monte Carlo batches: with
+// -runs N each scenario runs N times with seeds S, S+1, ... (S defaults to the scenario
+// seed, or 1 when neither is set). -archive also writes every run as a replayable archive into DIR;
+// -replay re-dispatches archived events and reports any result that differs from the recording.
+// -debug steps through one scenario in an interactive REPL with breakpoints on event types and
+// entities, and shows the pending queue and entity components between events (type help there).
+// The command exits with status 1 if any scenario fails or any replay does not match.
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"tft-dps-simulator/internal/core/data"
+	"tft-dps-simulator/internal/service"
+)
+
+// runResult is the outcome of one run of one scenario.
+type runResult struct {
+	Scenario    string                             `json:"scenario"`
+	Run         int                                `json:"run"`
+	Seed        int64                              `json:"seed"`
+	Error       string                             `json:"error,omitempty"`
+	Results     []service.ChampionSimulationResult `json:"results,omitempty"`
+	Targets     []service.TargetSimulationResult   `json:"targets,omitempty"`
+	Warnings    []string                           `json:"warnings,omitempty"`
+	DataVersion service.DataVersionInfo            `json:"dataVersion"`
+}
+
+func main() {
+	dataDir := flag.String("data", "./assets", "directory with en_us_<patch>.json data files")
+	setIDs := flag.String("sets", "TFTSet14", "comma separated set mutators to load")
+	defaultSet := flag.String("default-set", "TFTSet14", "set used when a scenario names none")
+	defaultPatch := flag.String("default-patch", "14.5", "patch used when a scenario names none")
+	overridesFile := flag.String("overrides", "", "balance overrides file applied to the loaded data")
+	format := flag.String("format", "table", "output format: table, json or csv")
+	runs := flag.Int("runs", 1, "runs per scenario, with consecutive seeds")
+	jitter := flag.Bool("jitter", false, "randomize the order of simultaneous events (for Monte Carlo runs)")
+	seed := flag.Int64("seed", 0, "jitter seed for the first run (overrides the scenario seed)")
+	archiveDir := flag.String("archive", "", "directory to write a replayable archive of every run to")
+	replay := flag.Bool("replay", false, "treat the arguments as archives and replay them")
+	debug := flag.Bool("debug", false, "step through the scenario interactively")
+	verbose := flag.Bool("v", false, "keep simulation logs on stderr")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "Usage: tftsim [flags] scenario.json ...")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	if *format != "table" && *format != "json" && *format != "csv" {
+		fmt.Fprintf(os.Stderr, "Unknown format %q (want table, json or csv)\n", *format)
+		os.Exit(2)
+	}
+
+	// The simulation logs every event; keep stdout for the report.
+	// The debugger shows them on demand with its trace command.
+	if !*verbose || *debug {
+		log.SetOutput(io.Discard)
+	}
+	registry, err := data.FileSource{
+		Dir:           *dataDir,
+		SetIDs:        strings.Split(*setIDs, ","),
+		DefaultSet:    *defaultSet,
+		DefaultPatch:  *defaultPatch,
+		OverridesFile: *overridesFile,
+	}.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading set data: %v\n", err)
+		os.Exit(1)
+	}
+	simService := service.NewSimulationService(registry)
+
+	if *debug {
+		if flag.NArg() != 1 {
+			fmt.Fprintln(os.Stderr, "-debug takes exactly one scenario")
+			os.Exit(2)
+		}
+		if err := debugScenario(simService, flag.Arg(0), *seed, *jitter); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *replay {
+		if !replayArchives(simService, flag.Args()) {
+			os.Exit(1)
+		}
+		return
+	}
+
+	failed := false
+	results := []runResult{}
+	for _, file := range flag.Args() {
+		scenario, err := service.LoadScenarioFile(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+			continue
+		}
+		scenario.Jitter = scenario.Jitter || *jitter
+		for run := 0; run < *runs; run++ {
+			result := runScenario(simService, scenario, run, runSeed(scenario.Seed, *seed, *runs, run), *archiveDir)
+			if result.Error != "" {
+				fmt.Fprintf(os.Stderr, "%s (run %d): %s\n", result.Scenario, run, result.Error)
+				failed = true
+			}
+			results = append(results, result)
+		}
+	}
+
+	switch *format {
+	case "json":
+		err = writeJSON(os.Stdout, results)
+	case "csv":
+		err = writeCSV(os.Stdout, results)
+	default:
+		err = writeTable(os.Stdout, results)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing report: %v\n", err)
+		os.Exit(1)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// runSeed picks the seed for a run: -seed, else the scenario seed, else 1 when repeating runs.
+func runSeed(scenarioSeed, flagSeed int64, runs, run int) int64 {
+	seed := scenarioSeed
+	if flagSeed != 0 {
+		seed = flagSeed
+	}
+	if seed == 0 && runs > 1 {
+		seed = 1
+	}
+	if seed == 0 {
+		return 0
+	}
+	return seed + int64(run)
+}
+
+// runScenario validates and runs one scenario with the given seed.
+// With an archive directory the run is recorded and written there as well.
+func runScenario(simService *service.SimulationService, scenario *service.Scenario, run int, seed int64, archiveDir string) runResult {
+	result := runResult{Scenario: scenario.Name, Run: run, Seed: seed}
+	req := scenario.RunSimulationRequest
+	req.Seed = seed
+
+	warnings, err := simService.CheckRequest(req)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	var resp *service.RunSimulationResponse
+	if archiveDir == "" {
+		resp, err = simService.RunSimulationWithContext(context.Background(), req)
+	} else {
+		resp, err = recordScenario(simService, req, archiveDir, fmt.Sprintf("%s-run%d.json", scenarioSlug(scenario.Name), run))
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	sort.Slice(resp.Results, func(i, j int) bool {
+		if resp.Results[i].ChampionApiName != resp.Results[j].ChampionApiName {
+			return resp.Results[i].ChampionApiName < resp.Results[j].ChampionApiName
+		}
+		return resp.Results[i].ChampionEntityID < resp.Results[j].ChampionEntityID
+	})
+	result.Results = resp.Results
+	result.Targets = resp.Targets
+	result.Warnings = append(warnings, resp.Warnings...)
+	result.DataVersion = resp.DataVersion
+	return result
+}
+
+// recordScenario runs req as an archive, writes it to archiveDir and returns the archived results.
+func recordScenario(simService *service.SimulationService, req service.RunSimulationRequest, archiveDir, fileName string) (*service.RunSimulationResponse, error) {
+	archive, err := simService.RecordArchive(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(archiveDir, 0o755); err != nil {
+		return nil, err
+	}
+	file, err := os.Create(filepath.Join(archiveDir, fileName))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if err := service.WriteArchive(file, archive); err != nil {
+		return nil, err
+	}
+	return &service.RunSimulationResponse{
+		Results:     archive.Results,
+		Targets:     archive.Targets,
+		DataVersion: archive.DataVersion,
+	}, nil
+}
+
+// scenarioSlug turns a scenario name into a file name part.
+func scenarioSlug(name string) string {
+	name = strings.TrimSuffix(filepath.Base(name), ".json")
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// replayArchives replays every archive file and prints whether each reproduced its recording.
+// It returns false if any archive failed to load or replay differently.
+func replayArchives(simService *service.SimulationService, files []string) bool {
+	ok := true
+	for _, file := range files {
+		report, err := replayArchive(simService, file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+			ok = false
+			continue
+		}
+		if len(report.Mismatches) == 0 {
+			fmt.Printf("%s: %d events replayed, results match\n", file, report.EventsReplayed)
+			continue
+		}
+		ok = false
+		fmt.Printf("%s: %d events replayed, %d mismatches\n", file, report.EventsReplayed, len(report.Mismatches))
+		for _, mismatch := range report.Mismatches {
+			fmt.Printf("  %s\n", mismatch)
+		}
+	}
+	return ok
+}
+
+func replayArchive(simService *service.SimulationService, filePath string) (*service.ReplayReport, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	archive, err := service.ReadArchive(file)
+	if err != nil {
+		return nil, err
+	}
+	return simService.ReplayArchive(context.Background(), archive)
+}
+
+func writeJSON(w io.Writer, results []runResult) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+var csvHeader = []string{"scenario", "run", "seed", "champion", "totalDamage", "dps", "adDamage", "apDamage", "trueDamage", "autoAttackDamage", "spellDamage", "autoAttacks", "spellCasts"}
+
+func writeCSV(w io.Writer, results []runResult) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, result := range results {
+		for _, champ := range result.Results {
+			stats := champ.DamageStats
+			row := []string{
+				result.Scenario,
+				strconv.Itoa(result.Run),
+				strconv.FormatInt(result.Seed, 10),
+				champ.ChampionApiName,
+				formatFloat(stats.TotalDamage),
+				formatFloat(stats.DamagePerSecond),
+				formatFloat(stats.TotalADDamage),
+				formatFloat(stats.TotalAPDamage),
+				formatFloat(stats.TotalTrueDamage),
+				formatFloat(stats.AutoAttackDamage),
+				formatFloat(stats.SpellDamage),
+				strconv.Itoa(stats.TotalAutoAttackCounts),
+				strconv.Itoa(stats.TotalSpellCastCounts),
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeTable(w io.Writer, results []runResult) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	for _, result := range results {
+		fmt.Fprintf(tw, "== %s (run %d, seed %d, %s) ==\n", result.Scenario, result.Run, result.Seed, result.DataVersion.Version)
+		if result.Error != "" {
+			fmt.Fprintf(tw, "error: %s\n\n", result.Error)
+			continue
+		}
+		fmt.Fprintln(tw, "Champion\tTotal\tDPS\tAD\tAP\tTrue\tAutos\tCasts\t")
+		for _, champ := range result.Results {
+			stats := champ.DamageStats
+			fmt.Fprintf(tw, "%s\t%.0f\t%.1f\t%.0f\t%.0f\t%.0f\t%d\t%d\t\n", champ.ChampionApiName,
+				stats.TotalDamage, stats.DamagePerSecond, stats.TotalADDamage, stats.TotalAPDamage, stats.TotalTrueDamage,
+				stats.TotalAutoAttackCounts, stats.TotalSpellCastCounts)
+		}
+		for _, warning := range result.Warnings {
+			fmt.Fprintf(tw, "warning: %s\n", warning)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
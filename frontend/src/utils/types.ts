@@ -102,7 +102,9 @@ export interface ChampionSimulationResult {
 }
 
 export interface EventItem {
+  type?: string; // Versioned event type name, e.g. "DamageAppliedEvent.v1" (see GET /api/v1/events/schema)
   Timestamp: number;
+  EnqueueTimestamp?: number;
   entity: number;
   Event: any; // Using 'any' as event structure may vary
 }
@@ -0,0 +1,105 @@
+package boards
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FileRepository stores each board as <code>.json in a directory.
+type FileRepository struct {
+	mu  sync.RWMutex
+	dir string
+}
+
+// NewFileRepository creates the directory if needed and returns a FileRepository rooted at it.
+func NewFileRepository(dir string) (*FileRepository, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create board directory %s: %w", dir, err)
+	}
+	return &FileRepository{dir: dir}, nil
+}
+
+func (r *FileRepository) path(code string) string {
+	return filepath.Join(r.dir, code+".json")
+}
+
+// Save implements Repository.
+func (r *FileRepository) Save(ctx context.Context, board SavedBoard) error {
+	if !IsValidCode(board.Code) {
+		return fmt.Errorf("invalid board code %q", board.Code)
+	}
+	encoded, err := json.MarshalIndent(board, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode board %s: %w", board.Code, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Write to a temp file first so readers never see a partial board.
+	tmp := r.path(board.Code) + ".tmp"
+	if err := os.WriteFile(tmp, encoded, 0o644); err != nil {
+		return fmt.Errorf("failed to write board %s: %w", board.Code, err)
+	}
+	return os.Rename(tmp, r.path(board.Code))
+}
+
+// Load implements Repository.
+func (r *FileRepository) Load(ctx context.Context, code string) (SavedBoard, error) {
+	if !IsValidCode(code) {
+		return SavedBoard{}, ErrBoardNotFound
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.readBoard(r.path(code))
+}
+
+func (r *FileRepository) readBoard(path string) (SavedBoard, error) {
+	encoded, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return SavedBoard{}, ErrBoardNotFound
+	}
+	if err != nil {
+		return SavedBoard{}, fmt.Errorf("failed to read board file %s: %w", path, err)
+	}
+	var board SavedBoard
+	if err := json.Unmarshal(encoded, &board); err != nil {
+		return SavedBoard{}, fmt.Errorf("failed to decode board file %s: %w", path, err)
+	}
+	return board, nil
+}
+
+// ListRecent implements Repository.
+func (r *FileRepository) ListRecent(ctx context.Context, limit int) ([]SavedBoard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list board directory %s: %w", r.dir, err)
+	}
+
+	result := []SavedBoard{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		board, err := r.readBoard(filepath.Join(r.dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		result = append(result, board)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package boards
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryRepository keeps boards in memory; they are lost on restart.
+type MemoryRepository struct {
+	mu     sync.RWMutex
+	boards map[string]SavedBoard
+	recent []string // Codes, most recent last; capped at MaxRecentBoards
+}
+
+// NewMemoryRepository creates an empty MemoryRepository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		boards: make(map[string]SavedBoard),
+	}
+}
+
+// Save implements Repository.
+func (r *MemoryRepository) Save(ctx context.Context, board SavedBoard) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.boards[board.Code]; !exists {
+		r.recent = append(r.recent, board.Code)
+		if len(r.recent) > MaxRecentBoards {
+			// Copy so the dropped codes do not stay in the backing array
+			r.recent = append([]string(nil), r.recent[len(r.recent)-MaxRecentBoards:]...)
+		}
+	}
+	r.boards[board.Code] = board
+	return nil
+}
+
+// Load implements Repository.
+func (r *MemoryRepository) Load(ctx context.Context, code string) (SavedBoard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	board, ok := r.boards[code]
+	if !ok {
+		return SavedBoard{}, ErrBoardNotFound
+	}
+	return board, nil
+}
+
+// ListRecent implements Repository.
+func (r *MemoryRepository) ListRecent(ctx context.Context, limit int) ([]SavedBoard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []SavedBoard{}
+	for i := len(r.recent) - 1; i >= 0 && len(result) < limit; i-- {
+		result = append(result, r.boards[r.recent[i]])
+	}
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package boards
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"tft-dps-simulator/internal/database"
+)
+
+const (
+	redisBoardKeyPrefix = "tftsim:board:"
+	redisRecentKey      = "tftsim:boards:recent"
+)
+
+// RedisRepository stores boards in Redis via the shared database.Service.
+// Boards do not expire; the recent list is capped at MaxRecentBoards.
+type RedisRepository struct {
+	db database.Service
+}
+
+// NewRedisRepository creates a RedisRepository.
+func NewRedisRepository(db database.Service) *RedisRepository {
+	return &RedisRepository{db: db}
+}
+
+// Save implements Repository.
+func (r *RedisRepository) Save(ctx context.Context, board SavedBoard) error {
+	encoded, err := json.Marshal(board)
+	if err != nil {
+		return fmt.Errorf("failed to encode board %s: %w", board.Code, err)
+	}
+	if err := r.db.Set(ctx, redisBoardKeyPrefix+board.Code, string(encoded), 0); err != nil {
+		return fmt.Errorf("failed to store board %s: %w", board.Code, err)
+	}
+	return r.db.ListPush(ctx, redisRecentKey, board.Code, MaxRecentBoards)
+}
+
+// Load implements Repository.
+func (r *RedisRepository) Load(ctx context.Context, code string) (SavedBoard, error) {
+	encoded, err := r.db.Get(ctx, redisBoardKeyPrefix+code)
+	if errors.Is(err, database.ErrKeyNotFound) {
+		return SavedBoard{}, ErrBoardNotFound
+	}
+	if err != nil {
+		return SavedBoard{}, fmt.Errorf("failed to load board %s: %w", code, err)
+	}
+	var board SavedBoard
+	if err := json.Unmarshal([]byte(encoded), &board); err != nil {
+		return SavedBoard{}, fmt.Errorf("failed to decode board %s: %w", code, err)
+	}
+	return board, nil
+}
+
+// ListRecent implements Repository.
+func (r *RedisRepository) ListRecent(ctx context.Context, limit int) ([]SavedBoard, error) {
+	if limit <= 0 {
+		return []SavedBoard{}, nil
+	}
+	codes, err := r.db.ListRange(ctx, redisRecentKey, 0, int64(limit-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent boards: %w", err)
+	}
+	result := make([]SavedBoard, 0, len(codes))
+	for _, code := range codes {
+		board, err := r.Load(ctx, code)
+		if err != nil {
+			continue
+		}
+		result = append(result, board)
+	}
+	return result, nil
+}
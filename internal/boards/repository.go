@@ -0,0 +1,75 @@
+package boards
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"tft-dps-simulator/internal/service"
+)
+
+// ErrBoardNotFound is returned when no board is stored under the given code.
+var ErrBoardNotFound = errors.New("board not found")
+
+// SavedBoard is a shareable simulation request stored under a short code.
+type SavedBoard struct {
+	Code      string                       `json:"code"`
+	Name      string                       `json:"name,omitempty"`
+	Request   service.RunSimulationRequest `json:"request"`
+	BoardHash string                       `json:"boardHash"` // Canonical hash, links the board to its cached result
+	CreatedAt time.Time                    `json:"createdAt"`
+}
+
+// Repository stores saved boards. Implementations must be safe for concurrent use.
+type Repository interface {
+	// Save stores the board under board.Code.
+	Save(ctx context.Context, board SavedBoard) error
+	// Load returns the board stored under code, or ErrBoardNotFound.
+	Load(ctx context.Context, code string) (SavedBoard, error)
+	// ListRecent returns up to limit boards, most recently saved first.
+	ListRecent(ctx context.Context, limit int) ([]SavedBoard, error)
+}
+
+const (
+	codeAlphabet = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789" // No 0/O, 1/l/I
+	codeLength   = 8
+	// MaxRecentBoards is how many boards ListRecent can return.
+	MaxRecentBoards = 100
+)
+
+// NewCode returns a random short code for a board.
+func NewCode() (string, error) {
+	code := make([]byte, codeLength)
+	alphabetSize := big.NewInt(int64(len(codeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		code[i] = codeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// IsValidCode reports whether code could have been produced by NewCode.
+// Used to reject path traversal and junk before hitting storage.
+func IsValidCode(code string) bool {
+	if len(code) != codeLength {
+		return false
+	}
+	for _, r := range code {
+		found := false
+		for _, a := range codeAlphabet {
+			if r == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+package boards
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tft-dps-simulator/internal/service"
+)
+
+func newTestBoard(t *testing.T, createdAt time.Time) SavedBoard {
+	code, err := NewCode()
+	if err != nil {
+		t.Fatalf("error generating code. Err: %v", err)
+	}
+	return SavedBoard{
+		Code: code,
+		Request: service.RunSimulationRequest{
+			BoardChampions: []service.BoardChampion{{ApiName: "TFT14_Kindred", Stars: 2}},
+		},
+		CreatedAt: createdAt,
+	}
+}
+
+func testRepository(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	base := time.Now().UTC()
+	first := newTestBoard(t, base)
+	second := newTestBoard(t, base.Add(time.Second))
+
+	for _, board := range []SavedBoard{first, second} {
+		if err := repo.Save(ctx, board); err != nil {
+			t.Fatalf("error saving board. Err: %v", err)
+		}
+	}
+
+	loaded, err := repo.Load(ctx, first.Code)
+	if err != nil {
+		t.Fatalf("error loading board. Err: %v", err)
+	}
+	if loaded.Code != first.Code || len(loaded.Request.BoardChampions) != 1 {
+		t.Errorf("loaded board does not match saved board: %+v", loaded)
+	}
+
+	if _, err := repo.Load(ctx, "zzzzzzzz"); !errors.Is(err, ErrBoardNotFound) {
+		t.Errorf("expected ErrBoardNotFound; got %v", err)
+	}
+
+	recent, err := repo.ListRecent(ctx, 1)
+	if err != nil {
+		t.Fatalf("error listing boards. Err: %v", err)
+	}
+	if len(recent) != 1 || recent[0].Code != second.Code {
+		t.Errorf("expected most recent board %s; got %+v", second.Code, recent)
+	}
+}
+
+func TestMemoryRepository(t *testing.T) {
+	testRepository(t, NewMemoryRepository())
+}
+
+func TestMemoryRepositoryCapsRecentBoards(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+	var first, last SavedBoard
+	for i := 0; i < MaxRecentBoards+5; i++ {
+		last = newTestBoard(t, time.Now().UTC())
+		if i == 0 {
+			first = last
+		}
+		if err := repo.Save(ctx, last); err != nil {
+			t.Fatalf("error saving board. Err: %v", err)
+		}
+	}
+
+	if len(repo.recent) != MaxRecentBoards {
+		t.Errorf("expected %d recent codes; got %d", MaxRecentBoards, len(repo.recent))
+	}
+	recent, err := repo.ListRecent(ctx, MaxRecentBoards+5)
+	if err != nil {
+		t.Fatalf("error listing boards. Err: %v", err)
+	}
+	if len(recent) != MaxRecentBoards || recent[0].Code != last.Code {
+		t.Errorf("expected the %d most recent boards, newest first; got %d", MaxRecentBoards, len(recent))
+	}
+	if _, err := repo.Load(ctx, first.Code); err != nil {
+		t.Errorf("expected boards dropped from the recent list to still load; got %v", err)
+	}
+}
+
+func TestFileRepository(t *testing.T) {
+	repo, err := NewFileRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("error creating file repository. Err: %v", err)
+	}
+	testRepository(t, repo)
+}
+
+func TestIsValidCode(t *testing.T) {
+	code, err := NewCode()
+	if err != nil {
+		t.Fatalf("error generating code. Err: %v", err)
+	}
+	if !IsValidCode(code) {
+		t.Errorf("expected generated code %q to be valid", code)
+	}
+	for _, bad := range []string{"", "../../etc", "abc", "abcdefg0"} {
+		if IsValidCode(bad) {
+			t.Errorf("expected %q to be invalid", bad)
+		}
+	}
+}
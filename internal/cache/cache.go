@@ -0,0 +1,14 @@
+package cache
+
+import (
+	"context"
+)
+
+// ResultCache stores serialized simulation results under a board hash.
+// Implementations must be safe for concurrent use.
+type ResultCache interface {
+	// Get returns the cached value for key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key, replacing any previous value.
+	Set(ctx context.Context, key string, value []byte) error
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrValueTooLarge is returned by LRUCache.Set when a value alone exceeds the byte limit.
+var ErrValueTooLarge = errors.New("value exceeds the cache byte limit")
+
+// LRUCache is an in-memory ResultCache that evicts the least recently used entries
+// once either capacity or the byte limit is reached. Intended for local/dev use.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	maxBytes int                      // Limit on the summed value sizes (0 for none)
+	size     int                      // Summed value sizes
+	order    *list.List               // Front is most recently used
+	entries  map[string]*list.Element // key -> element holding *lruEntry
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// NewLRUCache creates an LRUCache holding at most capacity entries (minimum 1) whose values
+// total at most maxBytes (0 for no byte limit). Results carry their full event log, so the
+// byte limit is what bounds memory use in practice.
+func NewLRUCache(capacity int, maxBytes int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	if maxBytes < 0 {
+		maxBytes = 0
+	}
+	return &LRUCache{
+		capacity: capacity,
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Get implements ResultCache.
+func (c *LRUCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, true, nil
+}
+
+// Set implements ResultCache. A value larger than the byte limit is not stored.
+func (c *LRUCache) Set(ctx context.Context, key string, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.maxBytes > 0 && len(value) > c.maxBytes {
+		return ErrValueTooLarge
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		c.size += len(value) - len(entry.value)
+		entry.value = value
+		c.order.MoveToFront(elem)
+	} else {
+		c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value})
+		c.size += len(value)
+	}
+
+	for c.order.Len() > c.capacity || (c.maxBytes > 0 && c.size > c.maxBytes) {
+		oldest := c.order.Back()
+		entry := oldest.Value.(*lruEntry)
+		c.order.Remove(oldest)
+		delete(c.entries, entry.key)
+		c.size -= len(entry.value)
+	}
+	return nil
+}
+
+// Len returns the number of cached entries.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Size returns the summed size of the cached values in bytes.
+func (c *LRUCache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRUCache(2, 0)
+
+	c.Set(ctx, "a", []byte("1"))
+	c.Set(ctx, "b", []byte("2"))
+	// Touch "a" so "b" becomes the least recently used entry.
+	if _, ok, _ := c.Get(ctx, "a"); !ok {
+		t.Fatalf("expected key a to be cached")
+	}
+	c.Set(ctx, "c", []byte("3"))
+
+	if _, ok, _ := c.Get(ctx, "b"); ok {
+		t.Errorf("expected key b to be evicted")
+	}
+	if value, ok, _ := c.Get(ctx, "a"); !ok || string(value) != "1" {
+		t.Errorf("expected key a to be 1; got %q (found %v)", value, ok)
+	}
+	if value, ok, _ := c.Get(ctx, "c"); !ok || string(value) != "3" {
+		t.Errorf("expected key c to be 3; got %q (found %v)", value, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("expected 2 entries; got %d", c.Len())
+	}
+}
+
+func TestLRUCacheEvictsToStayUnderByteLimit(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRUCache(10, 8)
+
+	c.Set(ctx, "a", []byte("1234"))
+	c.Set(ctx, "b", []byte("5678"))
+	c.Set(ctx, "c", []byte("90")) // 10 bytes in total; "a" is evicted
+
+	if _, ok, _ := c.Get(ctx, "a"); ok {
+		t.Errorf("expected key a to be evicted")
+	}
+	if c.Len() != 2 || c.Size() != 6 {
+		t.Errorf("expected 2 entries of 6 bytes; got %d of %d bytes", c.Len(), c.Size())
+	}
+
+	// Replacing a value counts only the new size
+	c.Set(ctx, "b", []byte("5"))
+	if c.Size() != 3 {
+		t.Errorf("expected 3 bytes after replacing key b; got %d", c.Size())
+	}
+
+	if err := c.Set(ctx, "d", []byte("too large")); err != ErrValueTooLarge {
+		t.Errorf("expected ErrValueTooLarge; got %v", err)
+	}
+	if _, ok, _ := c.Get(ctx, "d"); ok || c.Len() != 2 {
+		t.Errorf("expected the oversized value not to be stored or evict others; got %d entries", c.Len())
+	}
+}
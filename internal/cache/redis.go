@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tft-dps-simulator/internal/database"
+)
+
+// RedisCache is a ResultCache backed by the shared Redis database.Service.
+type RedisCache struct {
+	db     database.Service
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedisCache creates a RedisCache. Keys are stored as prefix+key and expire after ttl (0 for never).
+func NewRedisCache(db database.Service, prefix string, ttl time.Duration) *RedisCache {
+	return &RedisCache{
+		db:     db,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+// Get implements ResultCache.
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := c.db.Get(ctx, c.prefix+key)
+	if errors.Is(err, database.ErrKeyNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return []byte(value), true, nil
+}
+
+// Set implements ResultCache.
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte) error {
+	return c.db.Set(ctx, c.prefix+key, string(value), c.ttl)
+}
@@ -0,0 +1,54 @@
+package components
+
+// DefenseStats is the companion of DamageStats for the receiving side of combat:
+// damage taken, how much of it was prevented, and how long the unit stayed alive.
+type DefenseStats struct {
+	TotalDamageTaken         float64 `json:"totalDamageTaken"`
+	ADDamageTaken            float64 `json:"adDamageTaken"`
+	APDamageTaken            float64 `json:"apDamageTaken"`
+	TrueDamageTaken          float64 `json:"trueDamageTaken"`
+	PreMitigationDamageTaken float64 `json:"preMitigationDamageTaken"`
+	MitigatedByArmor         float64 `json:"mitigatedByArmor"`
+	MitigatedByMR            float64 `json:"mitigatedByMR"`
+	MitigatedByDurability    float64 `json:"mitigatedByDurability"`
+	ShieldAbsorbed           float64 `json:"shieldAbsorbed"` // No shield sources are simulated yet
+	HealingReceived          float64 `json:"healingReceived"`
+	TimeAlive                float64 `json:"timeAlive"`
+	TimeOfDeath              float64 `json:"timeOfDeath"` // -1 while alive
+}
+
+func NewDefenseStats() DefenseStats {
+	return DefenseStats{
+		TotalDamageTaken:         0.0,
+		ADDamageTaken:            0.0,
+		APDamageTaken:            0.0,
+		TrueDamageTaken:          0.0,
+		PreMitigationDamageTaken: 0.0,
+		MitigatedByArmor:         0.0,
+		MitigatedByMR:            0.0,
+		MitigatedByDurability:    0.0,
+		ShieldAbsorbed:           0.0,
+		HealingReceived:          0.0,
+		TimeAlive:                0.0,
+		TimeOfDeath:              -1.0,
+	}
+}
+
+// IsDead returns whether a time of death has been recorded.
+func (ds *DefenseStats) IsDead() bool {
+	return ds.TimeOfDeath >= 0
+}
+
+// FinalizeTimeAlive sets TimeAlive from the time of death, or the combat duration if the unit survived.
+func (ds *DefenseStats) FinalizeTimeAlive(combatDuration float64) {
+	if ds.IsDead() && ds.TimeOfDeath < combatDuration {
+		ds.TimeAlive = ds.TimeOfDeath
+	} else {
+		ds.TimeAlive = combatDuration
+	}
+}
+
+// GetTotalMitigated returns damage prevented by armor, magic resist and durability.
+func (ds *DefenseStats) GetTotalMitigated() float64 {
+	return ds.MitigatedByArmor + ds.MitigatedByMR + ds.MitigatedByDurability
+}
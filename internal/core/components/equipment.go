@@ -52,14 +52,13 @@ func (eq *Equipment) HasItem(itemApiName string) bool {
 
 // IsDuplicateUniqueItem checks if the user is attempting to add a unique item
 // that is already present.
-func (eq *Equipment) IsDuplicateUniqueItem(itemApiName string) bool {
-	itemToAdd := data.GetItemByApiName(itemApiName) // Check the item definition
+func (eq *Equipment) IsDuplicateUniqueItem(itemToAdd *data.Item) bool {
 	if itemToAdd == nil || !itemToAdd.Unique {
 		return false // Item not found or not unique, so no duplicate *unique* issue
 	}
 
 	// Check if an item with the same ApiName already exists in the slice
-	return eq.HasItem(itemApiName)
+	return eq.HasItem(itemToAdd.ApiName)
 }
 
 // RemoveItem removes the *first* occurrence of an item by its API name.
@@ -113,3 +112,10 @@ func (eq *Equipment) GetItemCount(itemApiName string) int {
 	}
 	return count
 }
+
+// Clone returns a copy with its own item slice. The items themselves are shared game data.
+func (eq *Equipment) Clone() *Equipment {
+	items := make([]*data.Item, len(eq.Items), eq.MaxSlots)
+	copy(items, eq.Items)
+	return &Equipment{Items: items, MaxSlots: eq.MaxSlots}
+}
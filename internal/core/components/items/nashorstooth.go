@@ -30,6 +30,12 @@ func (nt *NashorsToothEffect) IsActive(currentTime float64) bool {
     return nt.isActive && currentTime < nt.endTime
 }
 
+// IsBuffActive returns true while the attack speed bonus is applied, from ActivateBuff until
+// DeactivateBuff, without checking it against a time.
+func (nt *NashorsToothEffect) IsBuffActive() bool {
+    return nt.isActive
+}
+
 // ActivateBuff activates the attack speed buff.
 func (nt *NashorsToothEffect) ActivateBuff(currentTime float64) {
     nt.isActive = true
@@ -35,4 +35,8 @@ func (t *Traits) AddTrait(traitName string) {
 		}
 	}
 	t.list = append(t.list, traitName)
-}
\ No newline at end of file
+}
+// Clone returns a copy with its own trait list.
+func (t *Traits) Clone() *Traits {
+	return &Traits{list: append([]string(nil), t.list...)}
+}
@@ -0,0 +1,129 @@
+package components
+
+import (
+	"sort"
+)
+
+// Names used to record uptime for dynamic item and trait effects.
+// Debuffs are recorded under their debuffs.DebuffType string.
+const (
+	UptimeQuicksilver        = "Quicksilver"
+	UptimeBlueBuffDamageAmp  = "BlueBuffDamageAmp"
+	UptimeNashorsTooth       = "NashorsTooth"
+	UptimeEvenshroudResists  = "EvenshroudResists"
+	UptimeRapidfireMaxStacks = "RapidfireMaxStacks"
+)
+
+// UptimeInterval is a single [Start, End) window during which an effect was active.
+type UptimeInterval struct {
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
+
+// Duration returns the length of the interval in seconds.
+func (i UptimeInterval) Duration() float64 {
+	return i.End - i.Start
+}
+
+// EffectUptime records when buffs and debuffs were active on an entity.
+// Effects are keyed by name; an effect that is currently active has an open
+// interval which is closed by EndEffect or CloseAll.
+type EffectUptime struct {
+	intervals   map[string][]UptimeInterval
+	activeSince map[string]float64
+}
+
+// NewEffectUptime creates an empty EffectUptime component.
+func NewEffectUptime() *EffectUptime {
+	return &EffectUptime{
+		intervals:   make(map[string][]UptimeInterval),
+		activeSince: make(map[string]float64),
+	}
+}
+
+// StartEffect opens an interval for the effect at the given time.
+// Starting an effect that is already active is a no-op (refreshes keep the original start).
+func (u *EffectUptime) StartEffect(name string, timestamp float64) {
+	if _, active := u.activeSince[name]; active {
+		return
+	}
+	u.activeSince[name] = timestamp
+}
+
+// EndEffect closes the open interval for the effect at the given time.
+// Ending an effect that is not active is a no-op.
+func (u *EffectUptime) EndEffect(name string, timestamp float64) {
+	start, active := u.activeSince[name]
+	if !active {
+		return
+	}
+	delete(u.activeSince, name)
+	if timestamp < start {
+		timestamp = start
+	}
+	u.intervals[name] = append(u.intervals[name], UptimeInterval{Start: start, End: timestamp})
+}
+
+// IsEffectActive returns whether the effect currently has an open interval.
+func (u *EffectUptime) IsEffectActive(name string) bool {
+	_, active := u.activeSince[name]
+	return active
+}
+
+// CloseAll closes every open interval at the given time, e.g. at the end of combat.
+func (u *EffectUptime) CloseAll(timestamp float64) {
+	for name := range u.activeSince {
+		u.EndEffect(name, timestamp)
+	}
+}
+
+// GetIntervals returns the closed intervals recorded for the effect.
+func (u *EffectUptime) GetIntervals(name string) []UptimeInterval {
+	return u.intervals[name]
+}
+
+// GetActiveDuration returns the total time the effect was active across all closed intervals.
+func (u *EffectUptime) GetActiveDuration(name string) float64 {
+	total := 0.0
+	for _, interval := range u.intervals[name] {
+		total += interval.Duration()
+	}
+	return total
+}
+
+// GetUptimePercent returns the fraction (0-1) of combatDuration the effect was active.
+func (u *EffectUptime) GetUptimePercent(name string, combatDuration float64) float64 {
+	if combatDuration <= 0 {
+		return 0.0
+	}
+	return u.GetActiveDuration(name) / combatDuration
+}
+
+// GetEffectNames returns the names of all effects that have been recorded, sorted.
+func (u *EffectUptime) GetEffectNames() []string {
+	seen := make(map[string]bool)
+	names := []string{}
+	for name := range u.intervals {
+		seen[name] = true
+		names = append(names, name)
+	}
+	for name := range u.activeSince {
+		if !seen[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Clone returns a deep copy, so a branched simulation records uptime independently.
+func (u *EffectUptime) Clone() *EffectUptime {
+	clone := NewEffectUptime()
+	for name, intervals := range u.intervals {
+		clone.intervals[name] = append([]UptimeInterval(nil), intervals...)
+	}
+	for name, start := range u.activeSince {
+		clone.activeSince[name] = start
+	}
+	return clone
+}
@@ -0,0 +1,14 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+// TestData is the entry point for the Ginkgo test suite for the data package.
+func TestData(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Data Suite")
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+)
+
+// DataSet is the game data for one set on one patch, indexed for lookup.
+// A DataSet is never modified after it is built, so one handle can be shared by concurrent
+// simulations. Pass it to the factory, managers and systems that need game data.
+type DataSet struct {
+	SetID   string // Set mutator, e.g. "TFTSet14"
+	Patch   string // Patch label, e.g. "14.5" or "pbe"
+	Version string // "<setId>-<patch>-<content digest>", used in cache keys
+
+	Champions map[string]*Champion // ApiName -> Champion
+	Traits    map[string]*Trait    // Trait name -> Trait
+	Items     map[string]*Item     // ApiName -> set active item
+	Augments  map[string]*Item     // ApiName -> set active augment
+
+	AppliedOverrides []AppliedOverride // Balance overrides this DataSet was built with (see WithOverrides)
+}
+
+// LoadDataSet loads the set with the given mutator from a Community Dragon file.
+func LoadDataSet(filePath string, setID string, patch string) (*DataSet, error) {
+	setData, err := LoadSetDataFromFile(filePath, setID)
+	if err != nil {
+		return nil, err
+	}
+	allItems, err := LoadItemDataFromFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading item data: %v", err)
+	}
+	return NewDataSet(setData, allItems, patch), nil
+}
+
+// NewDataSet indexes already parsed set and item data. Only setData.SetData[0] is used.
+func NewDataSet(setData *TFTSetData, allItems []Item, patch string) *DataSet {
+	set := setData.SetData[0]
+	ds := &DataSet{
+		SetID:     set.Mutator,
+		Patch:     patch,
+		Champions: make(map[string]*Champion, len(set.Champions)),
+		Traits:    make(map[string]*Trait, len(set.Traits)),
+		Items:     make(map[string]*Item, len(set.SetItems)),
+		Augments:  make(map[string]*Item, len(set.SetAugments)),
+	}
+
+	for i := range set.Champions {
+		ds.Champions[set.Champions[i].ApiName] = &set.Champions[i]
+	}
+	for i := range set.Traits {
+		trait := &set.Traits[i]
+		// Tier lookups walk the effects in breakpoint order
+		sort.SliceStable(trait.Effects, func(a, b int) bool {
+			return trait.Effects[a].MinUnits < trait.Effects[b].MinUnits
+		})
+		ds.Traits[trait.Name] = trait
+	}
+
+	itemsByApiName := make(map[string]*Item, len(allItems))
+	for i := range allItems {
+		itemsByApiName[allItems[i].ApiName] = &allItems[i]
+	}
+	for _, apiName := range set.SetItems {
+		if item, found := itemsByApiName[apiName]; found {
+			ds.Items[apiName] = item
+		} else {
+			log.Printf("Warning: Set active item '%s' not found in item data for %s/%s\n", apiName, ds.SetID, patch)
+		}
+	}
+	for _, apiName := range set.SetAugments {
+		if augment, found := itemsByApiName[apiName]; found {
+			ds.Augments[apiName] = augment
+		}
+	}
+
+	ds.Version = fmt.Sprintf("%s-%s-%s", ds.SetID, patch, contentDigest(set, ds.Items, ds.Augments))
+	return ds
+}
+
+func contentDigest(content ...interface{}) string {
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return "unknown"
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// GetChampionByApiName returns a champion by API name or nil if not found
+func (ds *DataSet) GetChampionByApiName(apiName string) *Champion {
+	return ds.Champions[apiName]
+}
+
+// GetTraitByName returns a trait by name or nil if not found
+func (ds *DataSet) GetTraitByName(name string) *Trait {
+	return ds.Traits[name]
+}
+
+// GetTraitByApiName returns a trait by API name or nil if not found
+func (ds *DataSet) GetTraitByApiName(apiName string) *Trait {
+	for _, trait := range ds.Traits {
+		if trait.ApiName == apiName {
+			return trait
+		}
+	}
+	return nil
+}
+
+// GetItemByApiName returns a set active item by API name or nil if not found
+func (ds *DataSet) GetItemByApiName(apiName string) *Item {
+	return ds.Items[apiName]
+}
+
+// GetAugmentByApiName returns a set active augment by API name or nil if not found
+func (ds *DataSet) GetAugmentByApiName(apiName string) *Item {
+	return ds.Augments[apiName]
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+)
+
+// AbilityValueLength is the length of an ability variable's value array. Community Dragon
+// stores one value per star level at indices 1-3 and pads the array to 7 entries.
+const AbilityValueLength = 7
+
+// LintSeverity says whether a LintIssue breaks the simulation or is only suspicious.
+type LintSeverity string
+
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+)
+
+// LintIssue is one problem found in a data file.
+type LintIssue struct {
+	Severity LintSeverity `json:"severity"`
+	Kind     string       `json:"kind"` // "champion", "item" or "trait"
+	ApiName  string       `json:"apiName"`
+	Field    string       `json:"field"`
+	Message  string       `json:"message"`
+}
+
+// LintReport lists every issue found in one set of a data file.
+type LintReport struct {
+	SetID  string      `json:"setId"`
+	Issues []LintIssue `json:"issues"`
+}
+
+// Errors counts the issues with LintError severity.
+func (r LintReport) Errors() int {
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Severity == LintError {
+			count++
+		}
+	}
+	return count
+}
+
+// Warnings counts the issues with LintWarning severity.
+func (r LintReport) Warnings() int {
+	return len(r.Issues) - r.Errors()
+}
+
+// LintFile loads the set with the given mutator from a Community Dragon file and lints it.
+// The error is only set when the file cannot be read or parsed at all.
+func LintFile(filePath string, setID string) (LintReport, error) {
+	setData, err := LoadSetDataFromFile(filePath, setID)
+	if err != nil {
+		return LintReport{}, err
+	}
+	allItems, err := LoadItemDataFromFile(filePath)
+	if err != nil {
+		return LintReport{}, fmt.Errorf("error loading item data: %v", err)
+	}
+	return Lint(setData.SetData[0], allItems), nil
+}
+
+// Lint checks a set against the data the simulation relies on: champion stats, trait
+// references, item recipes and ability star values. Champions without traits (summons,
+// dummies and other non-shop units) only produce warnings.
+func Lint(set Set, allItems []Item) LintReport {
+	report := LintReport{SetID: set.Mutator, Issues: []LintIssue{}}
+	add := func(severity LintSeverity, kind, apiName, field, format string, args ...interface{}) {
+		report.Issues = append(report.Issues, LintIssue{
+			Severity: severity,
+			Kind:     kind,
+			ApiName:  apiName,
+			Field:    field,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	traitNames := make(map[string]bool, len(set.Traits))
+	for _, trait := range set.Traits {
+		traitNames[trait.Name] = true
+		if len(trait.Effects) == 0 {
+			add(LintWarning, "trait", trait.ApiName, "effects", "trait %s has no breakpoints", trait.Name)
+		}
+	}
+
+	for _, champion := range set.Champions {
+		severity := LintError
+		if len(champion.Traits) == 0 {
+			severity = LintWarning
+		}
+		lintStats(champion, severity, add)
+		for _, trait := range champion.Traits {
+			if !traitNames[trait] {
+				add(LintError, "champion", champion.ApiName, "traits", "unknown trait %q", trait)
+			}
+		}
+		for _, variable := range champion.Ability.Variables {
+			switch len(variable.Value) {
+			case AbilityValueLength:
+			case 0:
+				add(LintWarning, "champion", champion.ApiName, "ability."+variable.Name, "ability variable has no values")
+			default:
+				add(severity, "champion", champion.ApiName, "ability."+variable.Name,
+					"ability variable has %d values, expected %d", len(variable.Value), AbilityValueLength)
+			}
+		}
+	}
+
+	itemsByApiName := make(map[string]*Item, len(allItems))
+	for i := range allItems {
+		itemsByApiName[allItems[i].ApiName] = &allItems[i]
+	}
+	for _, apiName := range set.SetItems {
+		item, found := itemsByApiName[apiName]
+		if !found {
+			add(LintError, "item", apiName, "apiName", "set item missing from item data")
+			continue
+		}
+		for _, component := range item.Composition {
+			if _, known := itemsByApiName[component]; !known {
+				add(LintError, "item", apiName, "composition", "unknown component %q", component)
+			}
+		}
+	}
+	for _, apiName := range set.SetAugments {
+		if _, found := itemsByApiName[apiName]; !found {
+			add(LintWarning, "item", apiName, "apiName", "set augment missing from item data")
+		}
+	}
+
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		if report.Issues[i].Kind != report.Issues[j].Kind {
+			return report.Issues[i].Kind < report.Issues[j].Kind
+		}
+		return report.Issues[i].ApiName < report.Issues[j].ApiName
+	})
+	return report
+}
+
+// lintStats reports champion stats the simulation cannot run with.
+func lintStats(champion Champion, severity LintSeverity, add func(LintSeverity, string, string, string, string, ...interface{})) {
+	stats := champion.Stats
+	if stats.AttackSpeed <= 0 {
+		add(severity, "champion", champion.ApiName, "stats.attackSpeed", "attack speed is %g", stats.AttackSpeed)
+	}
+	if stats.HP <= 0 {
+		add(severity, "champion", champion.ApiName, "stats.hp", "missing HP")
+	}
+	if stats.Damage <= 0 {
+		add(severity, "champion", champion.ApiName, "stats.damage", "missing attack damage")
+	}
+	if stats.Range <= 0 {
+		add(severity, "champion", champion.ApiName, "stats.range", "missing attack range")
+	}
+	if stats.CritMultiplier <= 0 {
+		add(LintWarning, "champion", champion.ApiName, "stats.critMultiplier", "missing crit multiplier")
+	}
+	if stats.Mana < 0 || stats.InitialMana < 0 {
+		add(severity, "champion", champion.ApiName, "stats.mana", "negative mana (%g max, %g initial)", stats.Mana, stats.InitialMana)
+	}
+}
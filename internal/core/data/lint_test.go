@@ -0,0 +1,70 @@
+package data_test
+
+import (
+	"tft-dps-simulator/internal/core/data"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lint", func() {
+	var (
+		set      data.Set
+		allItems []data.Item
+	)
+
+	goodStats := data.Stats{AttackSpeed: 0.7, HP: 500, Damage: 50, Range: 4, CritMultiplier: 1.4, Mana: 60}
+	starValues := []float64{0, 100, 150, 225, 0, 0, 0}
+
+	BeforeEach(func() {
+		set = data.Set{
+			Mutator: "TFTSet14",
+			Traits:  []data.Trait{{ApiName: "TFT14_Rapidfire", Name: "Rapidfire", Effects: []data.Effect{{MinUnits: 2}}}},
+			Champions: []data.Champion{{
+				ApiName: "TFT14_KogMaw",
+				Stats:   goodStats,
+				Traits:  []string{"Rapidfire"},
+				Ability: data.Ability{Variables: []data.AbilityVariable{{Name: "Damage", Value: starValues}}},
+			}},
+			SetItems: []string{data.TFT_Item_Deathblade},
+		}
+		allItems = []data.Item{
+			{ApiName: data.TFT_Item_Deathblade, Composition: []string{"TFT_Item_BFSword", "TFT_Item_BFSword"}},
+			{ApiName: "TFT_Item_BFSword"},
+		}
+	})
+
+	It("should report nothing for a clean set", func() {
+		report := data.Lint(set, allItems)
+		Expect(report.Issues).To(BeEmpty())
+	})
+
+	It("should report broken champions and items as errors", func() {
+		set.Champions[0].Stats.AttackSpeed = 0
+		set.Champions[0].Traits = append(set.Champions[0].Traits, "Cyberboss")
+		set.Champions[0].Ability.Variables[0].Value = []float64{100, 150, 225}
+		allItems[0].Composition = []string{"TFT_Item_BFSword", "TFT_Item_Missing"}
+		set.SetItems = append(set.SetItems, "TFT_Item_Gone")
+
+		report := data.Lint(set, allItems)
+		Expect(report.Errors()).To(Equal(5))
+		fields := []string{}
+		for _, issue := range report.Issues {
+			fields = append(fields, issue.ApiName+" "+issue.Field)
+		}
+		Expect(fields).To(ContainElements(
+			"TFT14_KogMaw stats.attackSpeed",
+			"TFT14_KogMaw traits",
+			"TFT14_KogMaw ability.Damage",
+			data.TFT_Item_Deathblade+" composition",
+			"TFT_Item_Gone apiName",
+		))
+	})
+
+	It("should only warn about units without traits", func() {
+		set.Champions = append(set.Champions, data.Champion{ApiName: "TFT14_TrainingDummy"})
+		report := data.Lint(set, allItems)
+		Expect(report.Errors()).To(Equal(0))
+		Expect(report.Warnings()).To(BeNumerically(">", 0))
+	})
+})
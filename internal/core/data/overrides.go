@@ -0,0 +1,204 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Override sources reported in AppliedOverride.Source.
+const (
+	OverrideSourceFile    = "file"
+	OverrideSourceRequest = "request"
+)
+
+// Overrides patches loaded game data by ApiName, e.g. to model an upcoming balance change.
+type Overrides struct {
+	SetID     string                        `json:"setId,omitempty"` // Only used by override files: limit to one set (empty for all)
+	Champions map[string]ChampionOverride   `json:"champions,omitempty"`
+	Items     map[string]map[string]float64 `json:"items,omitempty"`  // Item ApiName -> Effects key -> value
+	Traits    map[string]TraitOverride      `json:"traits,omitempty"` // Trait ApiName -> override
+}
+
+// ChampionOverride replaces base stats of one champion.
+type ChampionOverride struct {
+	Stats   map[string]float64   `json:"stats,omitempty"`   // Stats JSON name (e.g. "attackSpeed") -> value
+	Ability map[string][]float64 `json:"ability,omitempty"` // Rejected until abilities use their variables
+}
+
+// TraitOverride replaces effect variables of one trait.
+type TraitOverride struct {
+	Effects map[int]map[string]float64 `json:"effects"` // Tier MinUnits -> variable -> value
+}
+
+// AppliedOverride records a single value changed by an override.
+type AppliedOverride struct {
+	Source  string      `json:"source"` // OverrideSourceFile or OverrideSourceRequest
+	Kind    string      `json:"kind"`   // "champion", "item" or "trait"
+	ApiName string      `json:"apiName"`
+	Field   string      `json:"field"` // e.g. "stats.attackSpeed", "effects.AS", "effects[2].AttackSpeed"
+	From    interface{} `json:"from"`
+	To      interface{} `json:"to"`
+}
+
+// LoadOverridesFromFile reads an overrides JSON file.
+func LoadOverridesFromFile(filePath string) (*Overrides, error) {
+	file, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading overrides file: %w", err)
+	}
+	var overrides Overrides
+	if err := json.Unmarshal(file, &overrides); err != nil {
+		return nil, fmt.Errorf("error parsing overrides JSON: %w", err)
+	}
+	return &overrides, nil
+}
+
+// WithOverrides returns a copy of ds with the overrides applied. ds itself is not modified;
+// only the overridden champions, items and traits are copied.
+// The copy's Version includes a digest of the overrides, and its AppliedOverrides lists every change.
+func (ds *DataSet) WithOverrides(overrides Overrides, source string) (*DataSet, error) {
+	patched := *ds
+	patched.Champions = copyMap(ds.Champions)
+	patched.Items = copyMap(ds.Items)
+	patched.Traits = copyMap(ds.Traits)
+	patched.AppliedOverrides = append([]AppliedOverride{}, ds.AppliedOverrides...)
+	record := func(kind, apiName, field string, from, to interface{}) {
+		patched.AppliedOverrides = append(patched.AppliedOverrides, AppliedOverride{
+			Source: source, Kind: kind, ApiName: apiName, Field: field, From: from, To: to,
+		})
+	}
+
+	for _, apiName := range sortedKeys(overrides.Champions) {
+		override := overrides.Champions[apiName]
+		base := ds.GetChampionByApiName(apiName)
+		if base == nil {
+			return nil, fmt.Errorf("champion override: unknown champion %q", apiName)
+		}
+		if len(override.Ability) > 0 {
+			// Abilities are simulated as generic AP-scaling damage, so the override would change nothing
+			return nil, fmt.Errorf("champion override %s: ability variables are not simulated yet", apiName)
+		}
+		champion := *base
+
+		for _, stat := range sortedKeys(override.Stats) {
+			field := champion.Stats.field(stat)
+			if field == nil {
+				return nil, fmt.Errorf("champion override %s: unknown stat %q", apiName, stat)
+			}
+			record("champion", apiName, "stats."+stat, *field, override.Stats[stat])
+			*field = override.Stats[stat]
+		}
+		patched.Champions[apiName] = &champion
+	}
+
+	for _, apiName := range sortedKeys(overrides.Items) {
+		base := ds.GetItemByApiName(apiName)
+		if base == nil {
+			return nil, fmt.Errorf("item override: unknown item %q", apiName)
+		}
+		item := *base
+		item.Effects = copyMap(base.Effects)
+		for _, key := range sortedKeys(overrides.Items[apiName]) {
+			from, ok := base.Effects[key]
+			if !ok {
+				return nil, fmt.Errorf("item override %s: unknown effect %q", apiName, key)
+			}
+			record("item", apiName, "effects."+key, from, overrides.Items[apiName][key])
+			item.Effects[key] = overrides.Items[apiName][key]
+		}
+		patched.Items[apiName] = &item
+	}
+
+	for _, apiName := range sortedKeys(overrides.Traits) {
+		base := ds.GetTraitByApiName(apiName)
+		if base == nil {
+			return nil, fmt.Errorf("trait override: unknown trait %q", apiName)
+		}
+		trait := *base
+		trait.Effects = make([]Effect, len(base.Effects))
+		for i, effect := range base.Effects {
+			effect.Variables = copyMap(effect.Variables)
+			trait.Effects[i] = effect
+		}
+
+		tierOverrides := overrides.Traits[apiName].Effects
+		minUnitsList := make([]int, 0, len(tierOverrides))
+		for minUnits := range tierOverrides {
+			minUnitsList = append(minUnitsList, minUnits)
+		}
+		sort.Ints(minUnitsList)
+
+		for _, minUnits := range minUnitsList {
+			tier := -1
+			for i, effect := range trait.Effects {
+				if effect.MinUnits == minUnits {
+					tier = i
+					break
+				}
+			}
+			if tier == -1 {
+				return nil, fmt.Errorf("trait override %s: no tier with minUnits %d", apiName, minUnits)
+			}
+			for _, name := range sortedKeys(tierOverrides[minUnits]) {
+				from, ok := trait.Effects[tier].Variables[name]
+				if !ok {
+					return nil, fmt.Errorf("trait override %s: unknown variable %q at %d units", apiName, name, minUnits)
+				}
+				record("trait", apiName, fmt.Sprintf("effects[%d].%s", minUnits, name), from, tierOverrides[minUnits][name])
+				trait.Effects[tier].Variables[name] = tierOverrides[minUnits][name]
+			}
+		}
+		patched.Traits[trait.Name] = &trait
+	}
+
+	patched.Version = fmt.Sprintf("%s+%s", ds.Version, contentDigest(overrides))
+	return &patched, nil
+}
+
+// field returns a pointer to the stat with the given JSON name, or nil.
+func (s *Stats) field(name string) *float64 {
+	switch name {
+	case "armor":
+		return &s.Armor
+	case "attackSpeed":
+		return &s.AttackSpeed
+	case "critChance":
+		return &s.CritChance
+	case "critMultiplier":
+		return &s.CritMultiplier
+	case "damage":
+		return &s.Damage
+	case "hp":
+		return &s.HP
+	case "initialMana":
+		return &s.InitialMana
+	case "magicResist":
+		return &s.MagicResist
+	case "mana":
+		return &s.Mana
+	case "range":
+		return &s.Range
+	default:
+		return nil
+	}
+}
+
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	copied := make(map[K]V, len(m))
+	for k, v := range m {
+		copied[k] = v
+	}
+	return copied
+}
+
+// sortedKeys keeps AppliedOverrides in a stable order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
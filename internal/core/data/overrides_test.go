@@ -0,0 +1,82 @@
+package data_test
+
+import (
+	"tft-dps-simulator/internal/core/data"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WithOverrides", func() {
+	var base *data.DataSet
+
+	BeforeEach(func() {
+		base = &data.DataSet{
+			SetID:   "TFTSet14",
+			Patch:   "14.5",
+			Version: "TFTSet14-14.5-abc",
+			Champions: map[string]*data.Champion{
+				"TFT14_KogMaw": {
+					ApiName: "TFT14_KogMaw",
+					Stats:   data.Stats{AttackSpeed: 0.7},
+					Ability: data.Ability{Variables: []data.AbilityVariable{{Name: "Damage", Value: []float64{100, 150, 225}}}},
+				},
+			},
+			Items: map[string]*data.Item{
+				data.TFT_Item_Deathblade: {ApiName: data.TFT_Item_Deathblade, Effects: map[string]float64{"AD": 0.55}},
+			},
+			Traits: map[string]*data.Trait{
+				"Rapidfire": {ApiName: "TFT14_Rapidfire", Name: "Rapidfire", Effects: []data.Effect{
+					{MinUnits: 2, Variables: map[string]float64{"AttackSpeed": 10}},
+					{MinUnits: 4, Variables: map[string]float64{"AttackSpeed": 20}},
+				}},
+			},
+		}
+	})
+
+	It("should patch copies and leave the base data set untouched", func() {
+		patched, err := base.WithOverrides(data.Overrides{
+			Champions: map[string]data.ChampionOverride{
+				"TFT14_KogMaw": {Stats: map[string]float64{"attackSpeed": 0.75}},
+			},
+			Items:  map[string]map[string]float64{data.TFT_Item_Deathblade: {"AD": 0.6}},
+			Traits: map[string]data.TraitOverride{"TFT14_Rapidfire": {Effects: map[int]map[string]float64{4: {"AttackSpeed": 25}}}},
+		}, data.OverrideSourceRequest)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(patched.GetChampionByApiName("TFT14_KogMaw").Stats.AttackSpeed).To(Equal(0.75))
+		Expect(patched.GetItemByApiName(data.TFT_Item_Deathblade).Effects["AD"]).To(Equal(0.6))
+		Expect(patched.GetTraitByName("Rapidfire").Effects[1].Variables["AttackSpeed"]).To(Equal(25.0))
+		Expect(patched.AppliedOverrides).To(HaveLen(3))
+		Expect(patched.Version).NotTo(Equal(base.Version))
+
+		Expect(base.GetChampionByApiName("TFT14_KogMaw").Stats.AttackSpeed).To(Equal(0.7))
+		Expect(base.GetItemByApiName(data.TFT_Item_Deathblade).Effects["AD"]).To(Equal(0.55))
+		Expect(base.GetTraitByName("Rapidfire").Effects[1].Variables["AttackSpeed"]).To(Equal(20.0))
+		Expect(base.AppliedOverrides).To(BeEmpty())
+	})
+
+	It("should reject ability overrides, which are not simulated yet", func() {
+		_, err := base.WithOverrides(data.Overrides{
+			Champions: map[string]data.ChampionOverride{"TFT14_KogMaw": {Ability: map[string][]float64{"Damage": {110, 165, 250}}}},
+		}, data.OverrideSourceRequest)
+		Expect(err).To(MatchError(ContainSubstring("not simulated")))
+	})
+
+	It("should reject overrides of unknown entries", func() {
+		_, err := base.WithOverrides(data.Overrides{
+			Champions: map[string]data.ChampionOverride{"TFT14_KogMaw": {Stats: map[string]float64{"speed": 1}}},
+		}, data.OverrideSourceRequest)
+		Expect(err).To(HaveOccurred())
+
+		_, err = base.WithOverrides(data.Overrides{
+			Items: map[string]map[string]float64{"TFT_Item_Nope": {"AD": 1}},
+		}, data.OverrideSourceRequest)
+		Expect(err).To(HaveOccurred())
+
+		_, err = base.WithOverrides(data.Overrides{
+			Traits: map[string]data.TraitOverride{"TFT14_Rapidfire": {Effects: map[int]map[string]float64{3: {"AttackSpeed": 1}}}},
+		}, data.OverrideSourceRequest)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,166 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DataSetInfo identifies a registered DataSet.
+type DataSetInfo struct {
+	SetID   string `json:"setId"`
+	Patch   string `json:"patch"`
+	Version string `json:"version"`
+	Default bool   `json:"default"`
+}
+
+// DataRegistry holds every loaded DataSet, keyed by set and patch.
+type DataRegistry struct {
+	mu         sync.RWMutex
+	sets       map[string]map[string]*DataSet // setID -> patch -> DataSet
+	defaultSet *DataSet
+}
+
+// NewDataRegistry creates an empty DataRegistry.
+func NewDataRegistry() *DataRegistry {
+	return &DataRegistry{
+		sets: make(map[string]map[string]*DataSet),
+	}
+}
+
+// Register adds ds, replacing any DataSet with the same set and patch.
+// The first registered DataSet becomes the default until SetDefault is called.
+func (r *DataRegistry) Register(ds *DataSet) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sets[ds.SetID] == nil {
+		r.sets[ds.SetID] = make(map[string]*DataSet)
+	}
+	r.sets[ds.SetID][ds.Patch] = ds
+	if r.defaultSet == nil || (r.defaultSet.SetID == ds.SetID && r.defaultSet.Patch == ds.Patch) {
+		r.defaultSet = ds
+	}
+}
+
+// SetDefault selects the DataSet used when a request names no set or patch.
+func (r *DataRegistry) SetDefault(setID, patch string) error {
+	ds, err := r.Get(setID, patch)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.defaultSet = ds
+	return nil
+}
+
+// Get returns the DataSet for setID and patch.
+// An empty setID means the default set; an empty patch means the default patch for
+// the default set, otherwise the highest registered patch of setID.
+func (r *DataRegistry) Get(setID, patch string) (*DataSet, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.defaultSet == nil {
+		return nil, fmt.Errorf("no game data loaded")
+	}
+	if setID == "" {
+		setID = r.defaultSet.SetID
+	}
+	patches, ok := r.sets[setID]
+	if !ok {
+		return nil, fmt.Errorf("unknown set %q", setID)
+	}
+	if patch == "" {
+		if setID == r.defaultSet.SetID {
+			return r.defaultSet, nil
+		}
+		return patches[latestPatch(patches)], nil
+	}
+	ds, ok := patches[patch]
+	if !ok {
+		return nil, fmt.Errorf("unknown patch %q for set %q", patch, setID)
+	}
+	return ds, nil
+}
+
+// Default returns the default DataSet, or nil if nothing is registered.
+func (r *DataRegistry) Default() *DataSet {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.defaultSet
+}
+
+// List describes every registered DataSet, sorted by set and patch.
+func (r *DataRegistry) List() []DataSetInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	infos := []DataSetInfo{}
+	for _, patches := range r.sets {
+		for _, ds := range patches {
+			infos = append(infos, DataSetInfo{
+				SetID:   ds.SetID,
+				Patch:   ds.Patch,
+				Version: ds.Version,
+				Default: ds == r.defaultSet,
+			})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].SetID != infos[j].SetID {
+			return infos[i].SetID < infos[j].SetID
+		}
+		return patchLess(infos[i].Patch, infos[j].Patch)
+	})
+	return infos
+}
+
+// latestPatch picks the highest patch label (see patchLess).
+func latestPatch(patches map[string]*DataSet) string {
+	latest := ""
+	for patch := range patches {
+		if latest == "" || patchLess(latest, patch) {
+			latest = patch
+		}
+	}
+	return latest
+}
+
+// patchLess orders patch labels numerically ("14.9" < "14.10"), with non-numeric labels such
+// as "pbe" after every numbered patch, in string order.
+func patchLess(a, b string) bool {
+	numA, okA := parsePatch(a)
+	numB, okB := parsePatch(b)
+	switch {
+	case okA && okB:
+		for i := 0; i < len(numA) && i < len(numB); i++ {
+			if numA[i] != numB[i] {
+				return numA[i] < numB[i]
+			}
+		}
+		if len(numA) != len(numB) {
+			return len(numA) < len(numB)
+		}
+		return a < b
+	case okA != okB:
+		return okA
+	default:
+		return a < b
+	}
+}
+
+// parsePatch splits a label like "14.10" into its numeric parts.
+func parsePatch(patch string) ([]int, bool) {
+	parts := strings.Split(patch, ".")
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		nums[i] = n
+	}
+	return nums, true
+}
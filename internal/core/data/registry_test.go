@@ -0,0 +1,92 @@
+package data_test
+
+import (
+	"tft-dps-simulator/internal/core/data"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DataRegistry", func() {
+	var (
+		registry *data.DataRegistry
+		set14Old *data.DataSet
+		set14New *data.DataSet
+		set13    *data.DataSet
+	)
+
+	BeforeEach(func() {
+		registry = data.NewDataRegistry()
+		set14Old = &data.DataSet{SetID: "TFTSet14", Patch: "14.4", Version: "a"}
+		set14New = &data.DataSet{SetID: "TFTSet14", Patch: "14.5", Version: "b"}
+		set13 = &data.DataSet{SetID: "TFTSet13", Patch: "13.24", Version: "c"}
+	})
+
+	It("should fail when nothing is registered", func() {
+		_, err := registry.Get("", "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("with several sets and patches", func() {
+		BeforeEach(func() {
+			registry.Register(set14Old)
+			registry.Register(set14New)
+			registry.Register(set13)
+		})
+
+		It("should default to the first registered data set", func() {
+			Expect(registry.Get("", "")).To(BeIdenticalTo(set14Old))
+		})
+
+		It("should select by set and patch", func() {
+			Expect(registry.SetDefault("TFTSet14", "14.5")).To(Succeed())
+			Expect(registry.Get("", "")).To(BeIdenticalTo(set14New))
+			Expect(registry.Get("", "14.4")).To(BeIdenticalTo(set14Old))
+			Expect(registry.Get("TFTSet13", "")).To(BeIdenticalTo(set13))
+		})
+
+		It("should reject unknown sets and patches", func() {
+			_, err := registry.Get("TFTSet14", "9.99")
+			Expect(err).To(HaveOccurred())
+			_, err = registry.Get("TFTSet1", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should list every data set and mark the default", func() {
+			infos := registry.List()
+			Expect(infos).To(HaveLen(3))
+			Expect(infos[0].SetID).To(Equal("TFTSet13"))
+			Expect(infos[1].Default).To(BeTrue())
+		})
+	})
+
+	Context("with double-digit patches", func() {
+		var set14Nine, set14Ten *data.DataSet
+
+		BeforeEach(func() {
+			set14Nine = &data.DataSet{SetID: "TFTSet14", Patch: "14.9", Version: "d"}
+			set14Ten = &data.DataSet{SetID: "TFTSet14", Patch: "14.10", Version: "e"}
+			registry.Register(set13) // Default set, so TFTSet14 resolves to its latest patch
+			registry.Register(set14Ten)
+			registry.Register(set14Nine)
+		})
+
+		It("should pick the latest patch numerically", func() {
+			Expect(registry.Get("TFTSet14", "")).To(BeIdenticalTo(set14Ten))
+		})
+
+		It("should order labels like pbe after every numbered patch", func() {
+			pbe := &data.DataSet{SetID: "TFTSet14", Patch: "pbe", Version: "f"}
+			registry.Register(pbe)
+			Expect(registry.Get("TFTSet14", "")).To(BeIdenticalTo(pbe))
+
+			patches := []string{}
+			for _, info := range registry.List() {
+				if info.SetID == "TFTSet14" {
+					patches = append(patches, info.Patch)
+				}
+			}
+			Expect(patches).To(Equal([]string{"14.9", "14.10", "pbe"}))
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileSource describes the game data files a DataRegistry is built from.
+// The server loads it at startup and again on every reload.
+type FileSource struct {
+	Dir           string   // Directory holding en_us_<patch>.json Community Dragon files
+	SetIDs        []string // Set mutators loaded from every file
+	DefaultSet    string   // Set used when a request names none
+	DefaultPatch  string   // Patch used when a request names none
+	OverridesFile string   // Optional balance overrides applied to every matching set
+}
+
+// Files lists the data files in Dir, sorted by name.
+func (src FileSource) Files() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(src.Dir, "en_us_*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// PatchFromFile derives the patch label from a data file name ("en_us_14.5.json" -> "14.5").
+func PatchFromFile(filePath string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(filepath.Base(filePath), "en_us_"), ".json")
+}
+
+// Load builds a new DataRegistry from the source. Sets missing from a file are skipped,
+// but any other problem fails the whole load so a broken snapshot is never served.
+func (src FileSource) Load() (*DataRegistry, error) {
+	var overrides *Overrides
+	if src.OverridesFile != "" {
+		loaded, err := LoadOverridesFromFile(src.OverridesFile)
+		if err != nil {
+			return nil, err
+		}
+		overrides = loaded
+	}
+
+	files, err := src.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	registry := NewDataRegistry()
+	for _, filePath := range files {
+		allItems, err := LoadItemDataFromFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filePath, err)
+		}
+		for _, setID := range src.SetIDs {
+			setID = strings.TrimSpace(setID)
+			setData, err := LoadSetDataFromFile(filePath, setID)
+			if err != nil {
+				log.Printf("Skipping %s for %s: %v", filePath, setID, err)
+				continue
+			}
+			ds := NewDataSet(setData, allItems, PatchFromFile(filePath))
+			if len(ds.Champions) == 0 {
+				return nil, fmt.Errorf("%s: set %s has no champions", filePath, setID)
+			}
+			if overrides != nil && (overrides.SetID == "" || overrides.SetID == ds.SetID) {
+				ds, err = ds.WithOverrides(*overrides, OverrideSourceFile)
+				if err != nil {
+					return nil, fmt.Errorf("applying balance overrides to %s: %w", filePath, err)
+				}
+			}
+			registry.Register(ds)
+			log.Printf("Loaded %s (%d champions, %d items)", ds.Version, len(ds.Champions), len(ds.Items))
+		}
+	}
+	if registry.Default() == nil {
+		return nil, fmt.Errorf("no set data found in %s", src.Dir)
+	}
+
+	if err := registry.SetDefault(src.DefaultSet, src.DefaultPatch); err != nil {
+		log.Printf("Default data %s/%s not loaded (%v); using %s", src.DefaultSet, src.DefaultPatch, err, registry.Default().Version)
+	}
+	return registry, nil
+}
+
+// Fingerprint summarizes the name, size and modification time of every file the source reads.
+// It changes whenever a data or overrides file is added, removed or rewritten.
+func (src FileSource) Fingerprint() (string, error) {
+	files, err := src.Files()
+	if err != nil {
+		return "", err
+	}
+	if src.OverridesFile != "" {
+		files = append(files, src.OverridesFile)
+	}
+
+	hash := sha256.New()
+	for _, filePath := range files {
+		info, err := os.Stat(filePath)
+		if err != nil {
+			fmt.Fprintf(hash, "%s:missing\n", filePath)
+			continue
+		}
+		fmt.Fprintf(hash, "%s:%d:%d\n", filePath, info.Size(), info.ModTime().UnixNano())
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
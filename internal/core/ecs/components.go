@@ -0,0 +1,47 @@
+package ecs
+
+import (
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/components/debuffs"
+	"tft-dps-simulator/internal/core/components/items"
+	"tft-dps-simulator/internal/core/components/traits"
+)
+
+// The components the simulation ships with, for AddComponent.
+func init() {
+	RegisterComponent[components.Health]()
+	RegisterComponent[components.Mana]()
+	RegisterComponent[components.Attack]()
+	RegisterComponent[components.Traits]()
+	RegisterComponent[components.ChampionInfo]()
+	RegisterComponent[components.Position]()
+	RegisterComponent[components.Team]()
+	RegisterComponent[items.ItemStaticEffect]()
+	RegisterComponent[components.Equipment]()
+	RegisterComponent[components.CanAbilityCritFromTraits]()
+	RegisterComponent[components.CanAbilityCritFromItems]()
+	RegisterComponent[components.Spell]()
+	RegisterComponent[components.Crit]()
+	RegisterComponent[components.State]()
+	RegisterComponent[components.DamageStats]()
+	RegisterComponent[components.EffectUptime]()
+	RegisterComponent[components.DefenseStats]()
+	RegisterComponent[items.ArchangelsStaffEffect]()
+	RegisterComponent[items.QuicksilverEffect]()
+	RegisterComponent[items.TitansResolveEffect]()
+	RegisterComponent[items.GuinsoosRagebladeEffect]()
+	RegisterComponent[items.SpiritVisageEffect]()
+	RegisterComponent[items.KrakensFuryEffect]()
+	RegisterComponent[items.SpearOfShojinEffect]()
+	RegisterComponent[items.BlueBuffEffect]()
+	RegisterComponent[items.FlickerbladeEffect]()
+	RegisterComponent[items.NashorsToothEffect]()
+	RegisterComponent[items.VoidStaffEffect]()
+	RegisterComponent[items.RedBuffEffect]()
+	RegisterComponent[items.EvenshroudEffect]()
+	RegisterComponent[traits.RapidfireEffect]()
+	RegisterComponent[debuffs.ShredEffect]()
+	RegisterComponent[debuffs.SunderEffect]()
+	RegisterComponent[debuffs.WoundEffect]()
+	RegisterComponent[debuffs.BurnEffect]()
+}
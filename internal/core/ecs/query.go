@@ -0,0 +1,132 @@
+package ecs
+
+import (
+	"tft-dps-simulator/internal/core/entity"
+)
+
+// storeOf returns T's store in w, or nil if no entity has ever had a T.
+func storeOf[T any](w *World) *componentStore[T] {
+	s, ok := w.stores[typeOf[T]()]
+	if !ok {
+		return nil
+	}
+	return s.(*componentStore[T])
+}
+
+// ensureStore returns T's store in w, creating it if needed.
+func ensureStore[T any](w *World) *componentStore[T] {
+	s := storeOf[T](w)
+	if s == nil {
+		s = newComponentStore[T]()
+		w.stores[typeOf[T]()] = s
+	}
+	return s
+}
+
+// Add sets e's T component, replacing any previous one.
+func Add[T any](w *World, e entity.Entity, component *T) {
+	s := ensureStore[T](w)
+	_, replaced := s.components[e]
+	s.components[e] = component
+	if !replaced {
+		w.componentAdded(typeOf[T](), e)
+	}
+}
+
+// Get returns e's T component.
+func Get[T any](w *World, e entity.Entity) (*T, bool) {
+	s := storeOf[T](w)
+	if s == nil {
+		return nil, false
+	}
+	c, ok := s.components[e]
+	return c, ok
+}
+
+// Has reports whether e has a T component.
+func Has[T any](w *World, e entity.Entity) bool {
+	_, ok := Get[T](w, e)
+	return ok
+}
+
+// Remove deletes e's T component, if any.
+func Remove[T any](w *World, e entity.Entity) {
+	s := storeOf[T](w)
+	if s == nil {
+		return
+	}
+	if _, ok := s.components[e]; ok {
+		delete(s.components, e)
+		w.componentRemoved(typeOf[T](), e)
+	}
+}
+
+// Query returns the entities with a T component, in entity order.
+// Queries are cached and kept current by the world; callers must not modify the result.
+func Query[T any](w *World) []entity.Entity {
+	key := viewKey{typeOf[T]()}
+	if v := w.cachedView(key); v != nil {
+		return v.result()
+	}
+	return w.addView(key, ensureStore[T](w)).result()
+}
+
+// Query2 returns the entities with both an A and a B component, in entity order.
+func Query2[A, B any](w *World) []entity.Entity {
+	key := viewKey{typeOf[A](), typeOf[B]()}
+	if v := w.cachedView(key); v != nil {
+		return v.result()
+	}
+	return w.addView(key, ensureStore[A](w), ensureStore[B](w)).result()
+}
+
+// Query3 returns the entities with an A, a B and a C component, in entity order.
+func Query3[A, B, C any](w *World) []entity.Entity {
+	key := viewKey{typeOf[A](), typeOf[B](), typeOf[C]()}
+	if v := w.cachedView(key); v != nil {
+		return v.result()
+	}
+	return w.addView(key, ensureStore[A](w), ensureStore[B](w), ensureStore[C](w)).result()
+}
+
+// Query4 returns the entities with an A, a B, a C and a D component, in entity order.
+func Query4[A, B, C, D any](w *World) []entity.Entity {
+	key := viewKey{typeOf[A](), typeOf[B](), typeOf[C](), typeOf[D]()}
+	if v := w.cachedView(key); v != nil {
+		return v.result()
+	}
+	return w.addView(key, ensureStore[A](w), ensureStore[B](w), ensureStore[C](w), ensureStore[D](w)).result()
+}
+
+// Each calls fn for every T component, in entity order.
+func Each[T any](w *World, fn func(e entity.Entity, component *T)) {
+	entities := Query[T](w)
+	if len(entities) == 0 {
+		return
+	}
+	s := storeOf[T](w)
+	for _, e := range entities {
+		if c, ok := s.components[e]; ok {
+			fn(e, c)
+		}
+	}
+}
+
+// filterEntities returns the entities of base that are in every store, in entity order.
+func filterEntities(base storage, stores ...storage) []entity.Entity {
+	candidates := base.entities()
+	result := candidates[:0]
+	for _, e := range candidates {
+		inAll := true
+		for _, s := range stores {
+			if !s.has(e) {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			result = append(result, e)
+		}
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package ecs_test
+
+import (
+	"reflect"
+	"testing"
+
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/components/debuffs"
+	"tft-dps-simulator/internal/core/ecs"
+	"tft-dps-simulator/internal/core/entity"
+)
+
+// benchmarkWorld builds a board-sized world: two teams of champions with the components
+// targeting looks for, and an unrelated component on half of them.
+func benchmarkWorld() (*ecs.World, []entity.Entity) {
+	world := ecs.NewWorld()
+	var champions []entity.Entity
+	for i := 0; i < 20; i++ {
+		e := world.NewEntity()
+		ecs.Add(world, e, &components.Team{ID: i % 2})
+		ecs.Add(world, e, &components.Position{})
+		ecs.Add(world, e, components.NewHealth(1000, 50, 50))
+		if i%2 == 0 {
+			ecs.Add(world, e, &components.Mana{})
+		}
+		champions = append(champions, e)
+	}
+	return world, champions
+}
+
+// BenchmarkGetEntitiesWithComponents is the query FindNearestEnemy runs for every attack and spell.
+func BenchmarkGetEntitiesWithComponents(b *testing.B) {
+	world, _ := benchmarkWorld()
+	posType := reflect.TypeOf(components.Position{})
+	healthType := reflect.TypeOf(components.Health{})
+	teamType := reflect.TypeOf(components.Team{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if len(world.GetEntitiesWithComponents(posType, healthType, teamType)) != 20 {
+			b.Fatal("wrong query result")
+		}
+	}
+}
+
+func BenchmarkQuery3(b *testing.B) {
+	world, _ := benchmarkWorld()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if len(ecs.Query3[components.Position, components.Health, components.Team](world)) != 20 {
+			b.Fatal("wrong query result")
+		}
+	}
+}
+
+// BenchmarkQuery3WithChurn adds and removes a debuff between queries, as a fight does.
+func BenchmarkQuery3WithChurn(b *testing.B) {
+	world, champions := benchmarkWorld()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		target := champions[i%len(champions)]
+		ecs.Add(world, target, &debuffs.ShredEffect{})
+		if len(ecs.Query3[components.Position, components.Health, components.Team](world)) != 20 {
+			b.Fatal("wrong query result")
+		}
+		ecs.Remove[debuffs.ShredEffect](world, target)
+	}
+}
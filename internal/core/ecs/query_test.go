@@ -0,0 +1,132 @@
+package ecs_test
+
+import (
+	"reflect"
+
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/ecs"
+	"tft-dps-simulator/internal/core/entity"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// augmentEffect stands in for a component defined outside the ecs package.
+type augmentEffect struct {
+	Stacks int
+}
+
+var _ = Describe("Typed component storage", func() {
+	var (
+		world  *ecs.World
+		first  entity.Entity
+		second entity.Entity
+		third  entity.Entity
+	)
+
+	BeforeEach(func() {
+		world = ecs.NewWorld()
+		first = world.NewEntity()
+		second = world.NewEntity()
+		third = world.NewEntity()
+	})
+
+	It("adds, gets and removes components by type", func() {
+		health := components.NewHealth(500, 30, 30)
+		ecs.Add(world, first, health)
+
+		got, ok := ecs.Get[components.Health](world, first)
+		Expect(ok).To(BeTrue())
+		Expect(got).To(BeIdenticalTo(health))
+		Expect(ecs.Has[components.Health](world, second)).To(BeFalse())
+		Expect(ecs.Has[components.Mana](world, first)).To(BeFalse())
+
+		ecs.Remove[components.Health](world, first)
+		Expect(ecs.Has[components.Health](world, first)).To(BeFalse())
+	})
+
+	It("shares storage with the untyped methods and the getters", func() {
+		Expect(world.AddComponent(first, components.Team{ID: 2})).To(Succeed())
+
+		team, ok := ecs.Get[components.Team](world, first)
+		Expect(ok).To(BeTrue())
+		Expect(team.ID).To(Equal(2))
+		fromGetter, _ := world.GetTeam(first)
+		Expect(fromGetter).To(BeIdenticalTo(team))
+		Expect(world.HasComponent(first, reflect.TypeOf(components.Team{}))).To(BeTrue())
+	})
+
+	It("queries entities with every requested component, in entity order", func() {
+		for _, e := range []entity.Entity{third, first, second} {
+			ecs.Add(world, e, &components.Team{ID: 1})
+		}
+		ecs.Add(world, third, components.NewHealth(100, 0, 0))
+		ecs.Add(world, first, components.NewHealth(100, 0, 0))
+		ecs.Add(world, first, &components.Position{})
+		ecs.Add(world, third, &components.Position{})
+		ecs.Add(world, second, &components.Position{})
+		ecs.Add(world, third, &components.Crit{})
+
+		Expect(ecs.Query[components.Team](world)).To(Equal([]entity.Entity{first, second, third}))
+		Expect(ecs.Query2[components.Team, components.Health](world)).To(Equal([]entity.Entity{first, third}))
+		Expect(ecs.Query3[components.Position, components.Team, components.Health](world)).To(Equal([]entity.Entity{first, third}))
+		Expect(ecs.Query4[components.Position, components.Team, components.Health, components.Crit](world)).To(Equal([]entity.Entity{third}))
+		Expect(ecs.Query2[components.Team, components.Mana](world)).To(BeEmpty())
+		Expect(world.GetEntitiesWithComponents(reflect.TypeOf(components.Team{}), reflect.TypeOf(components.Health{}))).
+			To(Equal([]entity.Entity{first, third}))
+	})
+
+	It("keeps cached queries current as components are added and removed", func() {
+		ecs.Add(world, second, &components.Team{ID: 1})
+		ecs.Add(world, second, &components.Position{})
+		before := ecs.Query2[components.Team, components.Position](world)
+		Expect(before).To(Equal([]entity.Entity{second}))
+
+		ecs.Add(world, first, &components.Team{ID: 2})
+		Expect(world.AddComponent(first, &components.Position{})).To(Succeed())
+		ecs.Add(world, third, &components.Position{})
+		Expect(ecs.Query2[components.Team, components.Position](world)).To(Equal([]entity.Entity{first, second}))
+		Expect(ecs.Query[components.Position](world)).To(Equal([]entity.Entity{first, second, third}))
+
+		world.RemoveComponent(second, reflect.TypeOf(components.Team{}))
+		Expect(ecs.Query2[components.Team, components.Position](world)).To(Equal([]entity.Entity{first}))
+		world.RemoveEntity(first)
+		Expect(ecs.Query2[components.Team, components.Position](world)).To(BeEmpty())
+
+		// Results handed out earlier are not changed underneath their holders.
+		Expect(before).To(Equal([]entity.Entity{second}))
+	})
+
+	It("visits components in entity order with Each", func() {
+		ecs.Add(world, second, &components.Team{ID: 2})
+		ecs.Add(world, first, &components.Team{ID: 1})
+
+		var ids []int
+		ecs.Each(world, func(_ entity.Entity, team *components.Team) {
+			ids = append(ids, team.ID)
+		})
+		Expect(ids).To(Equal([]int{1, 2}))
+	})
+
+	It("stores new component types without registration", func() {
+		ecs.Add(world, first, &augmentEffect{Stacks: 3})
+
+		effect, ok := ecs.Get[augmentEffect](world, first)
+		Expect(ok).To(BeTrue())
+		Expect(effect.Stacks).To(Equal(3))
+		Expect(world.Components(first)).To(HaveKey("augmentEffect"))
+
+		clone := world.Clone()
+		clonedEffect, _ := ecs.Get[augmentEffect](clone, first)
+		clonedEffect.Stacks = 5
+		Expect(effect.Stacks).To(Equal(3))
+
+		world.RemoveEntity(first)
+		Expect(ecs.Has[augmentEffect](world, first)).To(BeFalse())
+	})
+
+	It("rejects unregistered types in AddComponent", func() {
+		Expect(world.AddComponent(first, &struct{ Stacks int }{})).NotTo(Succeed())
+		Expect(world.AddComponent(first, nil)).NotTo(Succeed())
+	})
+})
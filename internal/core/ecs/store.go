@@ -0,0 +1,114 @@
+package ecs
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+
+	"tft-dps-simulator/internal/core/entity"
+)
+
+// storage is the type-erased view of a componentStore, for the World operations that work
+// across every component type (RemoveEntity, Clone, GetComponent, ...).
+type storage interface {
+	getAny(e entity.Entity) (interface{}, bool)
+	setAny(e entity.Entity, component interface{})
+	has(e entity.Entity) bool
+	remove(e entity.Entity)
+	len() int
+	entities() []entity.Entity
+	clone() storage
+}
+
+// componentStore holds every component of one type, keyed by entity.
+type componentStore[T any] struct {
+	components map[entity.Entity]*T
+}
+
+func newComponentStore[T any]() *componentStore[T] {
+	return &componentStore[T]{components: make(map[entity.Entity]*T)}
+}
+
+func (s *componentStore[T]) getAny(e entity.Entity) (interface{}, bool) {
+	c, ok := s.components[e]
+	return c, ok
+}
+
+func (s *componentStore[T]) setAny(e entity.Entity, component interface{}) {
+	s.components[e] = component.(*T)
+}
+
+func (s *componentStore[T]) has(e entity.Entity) bool {
+	_, ok := s.components[e]
+	return ok
+}
+
+func (s *componentStore[T]) remove(e entity.Entity) {
+	delete(s.components, e)
+}
+
+func (s *componentStore[T]) len() int {
+	return len(s.components)
+}
+
+// entities returns the entities in the store in entity order, so that systems visit
+// entities (and enqueue events) the same way on every run.
+func (s *componentStore[T]) entities() []entity.Entity {
+	result := make([]entity.Entity, 0, len(s.components))
+	for e := range s.components {
+		result = append(result, e)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+// cloner is implemented by components that hold slices or maps and must copy them when a world
+// is cloned. Other components are copied by value.
+type cloner[T any] interface {
+	Clone() *T
+}
+
+func (s *componentStore[T]) clone() storage {
+	copied := &componentStore[T]{components: make(map[entity.Entity]*T, len(s.components))}
+	for e, c := range s.components {
+		if c == nil {
+			copied.components[e] = nil
+			continue
+		}
+		if withClone, ok := any(c).(cloner[T]); ok {
+			copied.components[e] = withClone.Clone()
+			continue
+		}
+		value := *c
+		copied.components[e] = &value
+	}
+	return copied
+}
+
+// componentTypes maps component types to a constructor for their store, so that AddComponent can
+// create a store for a component it is given as interface{}. Add[T] needs no registration.
+var (
+	componentTypesMu sync.RWMutex
+	componentTypes   = map[reflect.Type]func() storage{}
+)
+
+// RegisterComponent makes T known to the untyped World methods (AddComponent with a T or *T).
+// The components the simulation ships with are registered by this package; packages adding new
+// item, trait or augment components register theirs in an init function, or only use Add[T].
+func RegisterComponent[T any]() {
+	componentTypesMu.Lock()
+	defer componentTypesMu.Unlock()
+	componentTypes[typeOf[T]()] = func() storage { return newComponentStore[T]() }
+}
+
+func storeConstructor(t reflect.Type) (func() storage, bool) {
+	componentTypesMu.RLock()
+	defer componentTypesMu.RUnlock()
+	newStore, ok := componentTypes[t]
+	return newStore, ok
+}
+
+// typeOf returns the key of T's store.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -0,0 +1,108 @@
+package ecs
+
+import (
+	"reflect"
+	"sort"
+
+	"tft-dps-simulator/internal/core/entity"
+)
+
+// maxViewTypes is the most component types a cached query can have. Longer queries are
+// computed on every call.
+const maxViewTypes = 4
+
+// viewKey identifies a query by its component types, in the order they were asked for.
+type viewKey [maxViewTypes]reflect.Type
+
+// view is a cached query: the entities that have every one of its component types, in entity
+// order. The world keeps it current as components are added and removed, so running the query
+// again costs a map lookup.
+//
+// entities is replaced, never changed in place, when membership changes. A slice returned by an
+// earlier query stays valid while the caller adds and removes components.
+type view struct {
+	stores   []storage
+	entities []entity.Entity
+}
+
+func newView(stores []storage) *view {
+	return &view{stores: stores, entities: filterEntities(smallestStore(stores), stores...)}
+}
+
+func (v *view) matches(e entity.Entity) bool {
+	for _, s := range v.stores {
+		if !s.has(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// result returns the view's entities. Callers must not modify the slice.
+func (v *view) result() []entity.Entity {
+	return v.entities[:len(v.entities):len(v.entities)]
+}
+
+func (v *view) insert(e entity.Entity) {
+	i := sort.Search(len(v.entities), func(i int) bool { return v.entities[i] >= e })
+	if i < len(v.entities) && v.entities[i] == e {
+		return
+	}
+	updated := make([]entity.Entity, 0, len(v.entities)+1)
+	updated = append(updated, v.entities[:i]...)
+	updated = append(updated, e)
+	v.entities = append(updated, v.entities[i:]...)
+}
+
+func (v *view) delete(e entity.Entity) {
+	i := sort.Search(len(v.entities), func(i int) bool { return v.entities[i] >= e })
+	if i == len(v.entities) || v.entities[i] != e {
+		return
+	}
+	updated := make([]entity.Entity, 0, len(v.entities)-1)
+	updated = append(updated, v.entities[:i]...)
+	v.entities = append(updated, v.entities[i+1:]...)
+}
+
+// cachedView returns the view for key, or nil if the query has not run yet.
+func (w *World) cachedView(key viewKey) *view {
+	return w.views[key]
+}
+
+// addView builds and caches the view for key over stores, which are in the same order.
+func (w *World) addView(key viewKey, stores ...storage) *view {
+	v := newView(stores)
+	w.views[key] = v
+	for _, t := range key {
+		if t != nil {
+			w.viewsByType[t] = append(w.viewsByType[t], v)
+		}
+	}
+	return v
+}
+
+// componentAdded updates the views over t after e got its first t component.
+func (w *World) componentAdded(t reflect.Type, e entity.Entity) {
+	for _, v := range w.viewsByType[t] {
+		if v.matches(e) {
+			v.insert(e)
+		}
+	}
+}
+
+// componentRemoved updates the views over t after e lost its t component.
+func (w *World) componentRemoved(t reflect.Type, e entity.Entity) {
+	for _, v := range w.viewsByType[t] {
+		v.delete(e)
+	}
+}
+
+func smallestStore(stores []storage) storage {
+	smallest := stores[0]
+	for _, s := range stores[1:] {
+		if s.len() < smallest.len() {
+			smallest = s
+		}
+	}
+	return smallest
+}
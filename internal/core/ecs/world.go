@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"sort"
 	"sync/atomic"
 
 	"tft-dps-simulator/internal/core/components"
@@ -13,96 +14,23 @@ import (
 	"tft-dps-simulator/internal/core/entity"
 )
 
-// World contains all entities and their components, stored in type-specific maps.
+// World contains all entities and their components. Each component type has its own store,
+// created the first time an entity gets a component of that type; use Add, Get, Has, Remove and
+// Query to work with them. New component types need no changes here.
 type World struct {
 	nextEntityId uint32
-	// --- Component Maps ---
-	// Using pointers (*components.Type) allows checking for nil to see if an entity.Entity has the component.
-	Health                   map[entity.Entity]*components.Health
-	Mana                     map[entity.Entity]*components.Mana
-	Attack                   map[entity.Entity]*components.Attack
-	Traits                   map[entity.Entity]*components.Traits
-	ChampionInfo             map[entity.Entity]*components.ChampionInfo
-	Position                 map[entity.Entity]*components.Position
-	Team                     map[entity.Entity]*components.Team
-	Item                     map[entity.Entity]*items.ItemStaticEffect
-	Equipment                map[entity.Entity]*components.Equipment
-	CanAbilityCritFromTraits map[entity.Entity]*components.CanAbilityCritFromTraits
-	CanAbilityCritFromItems  map[entity.Entity]*components.CanAbilityCritFromItems
-	Spell                    map[entity.Entity]*components.Spell
-	Crit                     map[entity.Entity]*components.Crit
-	State                    map[entity.Entity]*components.State
-	DamageStats              map[entity.Entity]*components.DamageStats
-
-	// --- Debuff Components ---
-	ShredEffects  map[entity.Entity]*debuffs.ShredEffect
-	SunderEffects map[entity.Entity]*debuffs.SunderEffect
-	WoundEffects  map[entity.Entity]*debuffs.WoundEffect
-	BurnEffects   map[entity.Entity]*debuffs.BurnEffect
-
-	// --- Dynamic Item Effect Components ---
-	ArchangelsStaffEffects   map[entity.Entity]*items.ArchangelsStaffEffect
-	QuicksilverEffects       map[entity.Entity]*items.QuicksilverEffect
-	TitansResolveEffects     map[entity.Entity]*items.TitansResolveEffect
-	GuinsoosRagebladeEffects map[entity.Entity]*items.GuinsoosRagebladeEffect
-	SpiritVisageEffects      map[entity.Entity]*items.SpiritVisageEffect
-	KrakensFuryEffects       map[entity.Entity]*items.KrakensFuryEffect
-	SpearOfShojinEffects     map[entity.Entity]*items.SpearOfShojinEffect
-	BlueBuffEffects          map[entity.Entity]*items.BlueBuffEffect
-	FlickerbladeEffects      map[entity.Entity]*items.FlickerbladeEffect
-	NashorsToothEffects      map[entity.Entity]*items.NashorsToothEffect
-	VoidStaffEffects         map[entity.Entity]*items.VoidStaffEffect
-	RedBuffEffects           map[entity.Entity]*items.RedBuffEffect
-	EvenshroudEffects 	 map[entity.Entity]*items.EvenshroudEffect
-
-	// --- Trait Effect Components ---
-	RapidfireEffects map[entity.Entity]*traits.RapidfireEffect
+	stores       map[reflect.Type]storage // Component type (not pointer) -> its store
+	views        map[viewKey]*view        // Cached queries
+	viewsByType  map[reflect.Type][]*view // Component type -> cached queries that use it
 }
 
-// NewWorld creates a new empty world, initializing all component maps.
+// NewWorld creates a new empty world.
 func NewWorld() *World {
 	return &World{
 		nextEntityId: 0,
-		// Initialize maps
-		Health:                   make(map[entity.Entity]*components.Health),
-		Mana:                     make(map[entity.Entity]*components.Mana),
-		Attack:                   make(map[entity.Entity]*components.Attack),
-		Traits:                   make(map[entity.Entity]*components.Traits),
-		ChampionInfo:             make(map[entity.Entity]*components.ChampionInfo),
-		Position:                 make(map[entity.Entity]*components.Position),
-		Team:                     make(map[entity.Entity]*components.Team),
-		Item:                     make(map[entity.Entity]*items.ItemStaticEffect),
-		Equipment:                make(map[entity.Entity]*components.Equipment),
-		CanAbilityCritFromTraits: make(map[entity.Entity]*components.CanAbilityCritFromTraits),
-		CanAbilityCritFromItems:  make(map[entity.Entity]*components.CanAbilityCritFromItems),
-		Spell:                    make(map[entity.Entity]*components.Spell),
-		Crit:                     make(map[entity.Entity]*components.Crit),
-		State:                    make(map[entity.Entity]*components.State),
-		DamageStats:              make(map[entity.Entity]*components.DamageStats),
-
-		// --- Debuff Components ---
-		ShredEffects:  make(map[entity.Entity]*debuffs.ShredEffect),
-		SunderEffects: make(map[entity.Entity]*debuffs.SunderEffect),
-		WoundEffects:  make(map[entity.Entity]*debuffs.WoundEffect),
-		BurnEffects:   make(map[entity.Entity]*debuffs.BurnEffect),
-
-		// --- Dynamic Item Effect Components ---
-		ArchangelsStaffEffects:   make(map[entity.Entity]*items.ArchangelsStaffEffect),
-		QuicksilverEffects:       make(map[entity.Entity]*items.QuicksilverEffect),
-		TitansResolveEffects:     make(map[entity.Entity]*items.TitansResolveEffect),
-		GuinsoosRagebladeEffects: make(map[entity.Entity]*items.GuinsoosRagebladeEffect),
-		SpiritVisageEffects:      make(map[entity.Entity]*items.SpiritVisageEffect),
-		KrakensFuryEffects:       make(map[entity.Entity]*items.KrakensFuryEffect),
-		SpearOfShojinEffects:     make(map[entity.Entity]*items.SpearOfShojinEffect),
-		BlueBuffEffects:          make(map[entity.Entity]*items.BlueBuffEffect),
-		FlickerbladeEffects:      make(map[entity.Entity]*items.FlickerbladeEffect),
-		NashorsToothEffects:      make(map[entity.Entity]*items.NashorsToothEffect),
-		VoidStaffEffects:         make(map[entity.Entity]*items.VoidStaffEffect),
-		RedBuffEffects:           make(map[entity.Entity]*items.RedBuffEffect),
-		EvenshroudEffects:        make(map[entity.Entity]*items.EvenshroudEffect),
-
-		// --- Traits ---
-		RapidfireEffects: make(map[entity.Entity]*traits.RapidfireEffect),
+		stores:       make(map[reflect.Type]storage),
+		views:        make(map[viewKey]*view),
+		viewsByType:  make(map[reflect.Type][]*view),
 	}
 }
 
@@ -115,763 +43,208 @@ func (w *World) NewEntity() entity.Entity {
 
 // RemoveEntity removes an entity and all its associated components from the world.
 func (w *World) RemoveEntity(e entity.Entity) {
-	// Delete the entity from every component map
-	delete(w.Health, e)
-	delete(w.Mana, e)
-	delete(w.Attack, e)
-	delete(w.Traits, e)
-	delete(w.ChampionInfo, e)
-	delete(w.Position, e)
-	delete(w.Team, e)
-	delete(w.Item, e)
-	delete(w.Equipment, e)
-	delete(w.CanAbilityCritFromTraits, e)
-	delete(w.CanAbilityCritFromItems, e)
-	delete(w.Spell, e)
-	delete(w.Crit, e)
-	delete(w.State, e)
-	delete(w.DamageStats, e)
-	// --- Debuff Components ---
-	delete(w.ShredEffects, e)
-	delete(w.SunderEffects, e)
-	delete(w.WoundEffects, e)
-	delete(w.BurnEffects, e)
-	// --- Dynamic Item Effect Components ---
-	delete(w.ArchangelsStaffEffects, e)
-	delete(w.QuicksilverEffects, e)
-	delete(w.TitansResolveEffects, e)
-	delete(w.GuinsoosRagebladeEffects, e)
-	delete(w.SpiritVisageEffects, e)
-	delete(w.KrakensFuryEffects, e)
-	delete(w.SpearOfShojinEffects, e)
-	delete(w.BlueBuffEffects, e)
-	delete(w.FlickerbladeEffects, e)
-	delete(w.NashorsToothEffects, e)
-	delete(w.VoidStaffEffects, e)
-	delete(w.RedBuffEffects, e)
-	delete(w.EvenshroudEffects, e)
-	// Traits
-	delete(w.RapidfireEffects, e)
-	// Delete from other maps here...
+	for componentType, s := range w.stores {
+		if s.has(e) {
+			s.remove(e)
+			w.componentRemoved(componentType, e)
+		}
+	}
 }
 
-// AddComponent adds a component to an entity.Entity. It uses a type switch
-// to place the component in the correct map.
-// Returns an error if the component type is not recognized by the World struct.
+// AddComponent adds a component, given as a value or a pointer, to an entity.Entity.
+// Values are copied. Returns an error if the component type was not registered with
+// RegisterComponent; Add[T] works for any type.
 func (w *World) AddComponent(e entity.Entity, component interface{}) error {
 	// Ensure component is not nil
 	if component == nil {
 		return fmt.Errorf("cannot add nil component to entity.Entity %d", e)
 	}
 
-	switch c := component.(type) {
-	// Handle both value and pointer types for convenience
-	case components.Health:
-		w.Health[e] = &c
-	case *components.Health:
-		w.Health[e] = c
-	case components.Mana:
-		w.Mana[e] = &c
-	case *components.Mana:
-		w.Mana[e] = c
-	case components.Attack:
-		w.Attack[e] = &c
-	case *components.Attack:
-		w.Attack[e] = c
-	case components.Traits:
-		w.Traits[e] = &c
-	case *components.Traits:
-		w.Traits[e] = c
-	case components.ChampionInfo:
-		w.ChampionInfo[e] = &c
-	case *components.ChampionInfo:
-		w.ChampionInfo[e] = c
-	case components.Position:
-		w.Position[e] = &c
-	case *components.Position:
-		w.Position[e] = c
-	case components.Team:
-		w.Team[e] = &c
-	case *components.Team:
-		w.Team[e] = c
-	case items.ItemStaticEffect:
-		w.Item[e] = &c
-	case *items.ItemStaticEffect:
-		w.Item[e] = c
-	case components.Equipment:
-		w.Equipment[e] = &c
-	case *components.Equipment:
-		w.Equipment[e] = c
-	case components.CanAbilityCritFromTraits:
-		w.CanAbilityCritFromTraits[e] = &c
-	case *components.CanAbilityCritFromTraits:
-		w.CanAbilityCritFromTraits[e] = c
-	case components.CanAbilityCritFromItems:
-		w.CanAbilityCritFromItems[e] = &c
-	case *components.CanAbilityCritFromItems:
-		w.CanAbilityCritFromItems[e] = c
-	case components.Spell:
-		w.Spell[e] = &c
-	case *components.Spell:
-		w.Spell[e] = c
-	case components.Crit:
-		w.Crit[e] = &c
-	case *components.Crit:
-		w.Crit[e] = c
-	case components.State:
-		w.State[e] = &c
-	case *components.State:
-		w.State[e] = c
-	case components.DamageStats:
-		w.DamageStats[e] = &c
-	case *components.DamageStats:
-		w.DamageStats[e] = c
-	// --- Debuff Components ---
-	case debuffs.ShredEffect:
-		w.ShredEffects[e] = &c
-	case *debuffs.ShredEffect:
-		w.ShredEffects[e] = c
-	case debuffs.SunderEffect:
-		w.SunderEffects[e] = &c
-	case *debuffs.SunderEffect:
-		w.SunderEffects[e] = c
-	case debuffs.WoundEffect:
-		w.WoundEffects[e] = &c
-	case *debuffs.WoundEffect:
-		w.WoundEffects[e] = c
-	case debuffs.BurnEffect:
-		w.BurnEffects[e] = &c
-	case *debuffs.BurnEffect:
-		w.BurnEffects[e] = c
-	// --- Dynamic Item Effect Components ---
-	case items.ArchangelsStaffEffect:
-		w.ArchangelsStaffEffects[e] = &c
-	case *items.ArchangelsStaffEffect:
-		w.ArchangelsStaffEffects[e] = c
-	case items.QuicksilverEffect:
-		w.QuicksilverEffects[e] = &c
-	case *items.QuicksilverEffect:
-		w.QuicksilverEffects[e] = c
-	case items.TitansResolveEffect:
-		w.TitansResolveEffects[e] = &c
-	case *items.TitansResolveEffect:
-		w.TitansResolveEffects[e] = c
-	case items.GuinsoosRagebladeEffect:
-		w.GuinsoosRagebladeEffects[e] = &c
-	case *items.GuinsoosRagebladeEffect:
-		w.GuinsoosRagebladeEffects[e] = c
-	case items.SpiritVisageEffect:
-		w.SpiritVisageEffects[e] = &c
-	case *items.SpiritVisageEffect:
-		w.SpiritVisageEffects[e] = c
-	case items.KrakensFuryEffect:
-		w.KrakensFuryEffects[e] = &c
-	case *items.KrakensFuryEffect:
-		w.KrakensFuryEffects[e] = c
-	case items.SpearOfShojinEffect:
-		w.SpearOfShojinEffects[e] = &c
-	case *items.SpearOfShojinEffect:
-		w.SpearOfShojinEffects[e] = c
-	case items.BlueBuffEffect:
-		w.BlueBuffEffects[e] = &c
-	case *items.BlueBuffEffect:
-		w.BlueBuffEffects[e] = c
-	case items.FlickerbladeEffect:
-		w.FlickerbladeEffects[e] = &c
-	case *items.FlickerbladeEffect:
-		w.FlickerbladeEffects[e] = c
-	case items.NashorsToothEffect:
-		w.NashorsToothEffects[e] = &c
-	case *items.NashorsToothEffect:
-		w.NashorsToothEffects[e] = c
-	case items.VoidStaffEffect:
-		w.VoidStaffEffects[e] = &c
-	case *items.VoidStaffEffect:
-		w.VoidStaffEffects[e] = c
-	case items.RedBuffEffect:
-		w.RedBuffEffects[e] = &c
-	case *items.RedBuffEffect:
-		w.RedBuffEffects[e] = c
-	case items.EvenshroudEffect:
-		w.EvenshroudEffects[e] = &c
-	case *items.EvenshroudEffect:
-		w.EvenshroudEffects[e] = c
-	// Traits
-	case traits.RapidfireEffect:
-		w.RapidfireEffects[e] = &c
-	case *traits.RapidfireEffect:
-		w.RapidfireEffects[e] = c
-	// Add cases for other component types here...
-	default:
-		// Use reflection to get the type name for the error message
-		return fmt.Errorf("unknown component type: %v", reflect.TypeOf(component))
+	value := reflect.ValueOf(component)
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return fmt.Errorf("cannot add nil component to entity.Entity %d", e)
+		}
+	} else {
+		// Handle both value and pointer types for convenience
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		value = pointer
+	}
+	componentType := value.Type().Elem()
+
+	s, ok := w.stores[componentType]
+	if !ok {
+		newStore, registered := storeConstructor(componentType)
+		if !registered {
+			return fmt.Errorf("unknown component type: %v", reflect.TypeOf(component))
+		}
+		s = newStore()
+		w.stores[componentType] = s
+	}
+	added := !s.has(e)
+	s.setAny(e, value.Interface())
+	if added {
+		w.componentAdded(componentType, e)
 	}
 	return nil
 }
 
 // GetComponent retrieves a component of a specific type for an entity.Entity.
 // It returns the component (as interface{}) and true if found, otherwise nil and false.
-// This generic version is kept for flexibility but type-safe getters are preferred.
+// This untyped version is kept for flexibility but Get[T] is preferred.
 func (w *World) GetComponent(e entity.Entity, componentType reflect.Type) (interface{}, bool) {
-	switch componentType {
-	case reflect.TypeOf(components.Health{}):
-		comp, ok := w.Health[e] // Use type-safe getter internally
-		return comp, ok
-	case reflect.TypeOf(components.Mana{}):
-		comp, ok := w.Mana[e]
-		return comp, ok
-	case reflect.TypeOf(components.Attack{}):
-		comp, ok := w.Attack[e]
-		return comp, ok
-	case reflect.TypeOf(components.Traits{}):
-		comp, ok := w.Traits[e]
-		return comp, ok
-	case reflect.TypeOf(components.ChampionInfo{}):
-		comp, ok := w.ChampionInfo[e]
-		return comp, ok
-	case reflect.TypeOf(components.Position{}):
-		comp, ok := w.Position[e]
-		return comp, ok
-	case reflect.TypeOf(components.Team{}):
-		comp, ok := w.Team[e]
-		return comp, ok
-	case reflect.TypeOf(items.ItemStaticEffect{}):
-		comp, ok := w.Item[e]
-		return comp, ok
-	case reflect.TypeOf(components.Equipment{}):
-		comp, ok := w.Equipment[e]
-		return comp, ok
-	case reflect.TypeOf(components.CanAbilityCritFromTraits{}):
-		comp, ok := w.CanAbilityCritFromTraits[e]
-		return comp, ok
-	case reflect.TypeOf(components.CanAbilityCritFromItems{}):
-		comp, ok := w.CanAbilityCritFromItems[e]
-		return comp, ok
-	case reflect.TypeOf(components.Spell{}):
-		comp, ok := w.Spell[e]
-		return comp, ok
-	case reflect.TypeOf(components.Crit{}):
-		comp, ok := w.Crit[e]
-		return comp, ok
-	case reflect.TypeOf(components.State{}):
-		comp, ok := w.State[e]
-		return comp, ok
-	case reflect.TypeOf(components.DamageStats{}):
-		comp, ok := w.DamageStats[e]
-		return comp, ok
-	// --- Debuff Components ---
-	case reflect.TypeOf(debuffs.ShredEffect{}):
-		comp, ok := w.ShredEffects[e]
-		return comp, ok
-	case reflect.TypeOf(debuffs.SunderEffect{}):
-		comp, ok := w.SunderEffects[e]
-		return comp, ok
-	case reflect.TypeOf(debuffs.WoundEffect{}):
-		comp, ok := w.WoundEffects[e]
-		return comp, ok
-	case reflect.TypeOf(debuffs.BurnEffect{}):
-		comp, ok := w.BurnEffects[e]
-		return comp, ok
-	// --- Dynamic Item Effect Components ---
-	case reflect.TypeOf(items.ArchangelsStaffEffect{}):
-		comp, ok := w.ArchangelsStaffEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.QuicksilverEffect{}):
-		comp, ok := w.QuicksilverEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.TitansResolveEffect{}):
-		comp, ok := w.TitansResolveEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.GuinsoosRagebladeEffect{}):
-		comp, ok := w.GuinsoosRagebladeEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.SpiritVisageEffect{}):
-		comp, ok := w.SpiritVisageEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.KrakensFuryEffect{}):
-		comp, ok := w.KrakensFuryEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.SpearOfShojinEffect{}):
-		comp, ok := w.SpearOfShojinEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.BlueBuffEffect{}):
-		comp, ok := w.BlueBuffEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.FlickerbladeEffect{}):
-		comp, ok := w.FlickerbladeEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.NashorsToothEffect{}):
-		comp, ok := w.NashorsToothEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.VoidStaffEffect{}):
-		comp, ok := w.VoidStaffEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.RedBuffEffect{}):
-		comp, ok := w.RedBuffEffects[e]
-		return comp, ok
-	case reflect.TypeOf(items.EvenshroudEffect{}):
-		comp, ok := w.EvenshroudEffects[e]
-		return comp, ok
-	// Traits
-	case reflect.TypeOf(traits.RapidfireEffect{}):
-		comp, ok := w.RapidfireEffects[e]
-		return comp, ok
-	// Add cases for other component types here...
-	default:
+	s, ok := w.stores[componentType]
+	if !ok {
 		return nil, false
 	}
+	return s.getAny(e)
 }
 
 // HasComponent checks if an entity.Entity possesses a component of the specified type.
 func (w *World) HasComponent(e entity.Entity, componentType reflect.Type) bool {
-	_, ok := w.GetComponent(e, componentType) // Leverage existing logic
-	return ok
+	s, ok := w.stores[componentType]
+	return ok && s.has(e)
 }
 
 // RemoveComponent removes a specific component type from an entity.Entity.
 func (w *World) RemoveComponent(e entity.Entity, componentType reflect.Type) {
-	switch componentType {
-	case reflect.TypeOf(components.Health{}):
-		delete(w.Health, e)
-	case reflect.TypeOf(components.Mana{}):
-		delete(w.Mana, e)
-	case reflect.TypeOf(components.Attack{}):
-		delete(w.Attack, e)
-	case reflect.TypeOf(components.Traits{}):
-		delete(w.Traits, e)
-	case reflect.TypeOf(components.ChampionInfo{}):
-		delete(w.ChampionInfo, e)
-	case reflect.TypeOf(components.Position{}):
-		delete(w.Position, e)
-	case reflect.TypeOf(components.Team{}):
-		delete(w.Team, e)
-	case reflect.TypeOf(items.ItemStaticEffect{}):
-		delete(w.Item, e)
-	case reflect.TypeOf(components.Equipment{}):
-		delete(w.Equipment, e)
-	case reflect.TypeOf(components.CanAbilityCritFromTraits{}):
-		delete(w.CanAbilityCritFromTraits, e)
-	case reflect.TypeOf(components.CanAbilityCritFromItems{}):
-		delete(w.CanAbilityCritFromItems, e)
-	case reflect.TypeOf(components.Spell{}):
-		delete(w.Spell, e)
-	case reflect.TypeOf(components.Crit{}):
-		delete(w.Crit, e)
-	case reflect.TypeOf(components.State{}):
-		delete(w.State, e)
-	case reflect.TypeOf(components.DamageStats{}):
-		delete(w.DamageStats, e)
-	// --- Debuff Components ---
-	case reflect.TypeOf(debuffs.ShredEffect{}):
-		delete(w.ShredEffects, e)
-	case reflect.TypeOf(debuffs.SunderEffect{}):
-		delete(w.SunderEffects, e)
-	case reflect.TypeOf(debuffs.WoundEffect{}):
-		delete(w.WoundEffects, e)
-	case reflect.TypeOf(debuffs.BurnEffect{}):
-		delete(w.BurnEffects, e)
-	// --- Dynamic Item Effect Components ---
-	case reflect.TypeOf(items.ArchangelsStaffEffect{}):
-		delete(w.ArchangelsStaffEffects, e)
-	case reflect.TypeOf(items.QuicksilverEffect{}):
-		delete(w.QuicksilverEffects, e)
-	case reflect.TypeOf(items.TitansResolveEffect{}):
-		delete(w.TitansResolveEffects, e)
-	case reflect.TypeOf(items.GuinsoosRagebladeEffect{}):
-		delete(w.GuinsoosRagebladeEffects, e)
-	case reflect.TypeOf(items.SpiritVisageEffect{}):
-		delete(w.SpiritVisageEffects, e)
-	case reflect.TypeOf(items.KrakensFuryEffect{}):
-		delete(w.KrakensFuryEffects, e)
-	case reflect.TypeOf(items.SpearOfShojinEffect{}):
-		delete(w.SpearOfShojinEffects, e)
-	case reflect.TypeOf(items.BlueBuffEffect{}):
-		delete(w.BlueBuffEffects, e)
-	case reflect.TypeOf(items.FlickerbladeEffect{}):
-		delete(w.FlickerbladeEffects, e)
-	case reflect.TypeOf(items.NashorsToothEffect{}):
-		delete(w.NashorsToothEffects, e)
-	case reflect.TypeOf(items.VoidStaffEffect{}):
-		delete(w.VoidStaffEffects, e)
-	case reflect.TypeOf(items.RedBuffEffect{}):
-		delete(w.RedBuffEffects, e)
-	case reflect.TypeOf(items.EvenshroudEffect{}):
-		delete(w.EvenshroudEffects, e)
-	// Traits
-	case reflect.TypeOf(traits.RapidfireEffect{}):
-		delete(w.RapidfireEffects, e)
-	// Add cases for other component types here...
-	default:
-		log.Printf("Warning: Attempted to remove unknown component type %v from entity.Entity %d\n", componentType, e)
+	s, ok := w.stores[componentType]
+	if !ok {
+		if _, registered := storeConstructor(componentType); !registered {
+			log.Printf("Warning: Attempted to remove unknown component type %v from entity.Entity %d\n", componentType, e)
+		}
+		return
 	}
-}
-
-// GetEntitiesWithComponents returns a slice of entities that possess ALL the specified component types.
-func (w *World) GetEntitiesWithComponents(componentTypes ...reflect.Type) []entity.Entity {
-	if len(componentTypes) == 0 {
-		return []entity.Entity{}
+	if s.has(e) {
+		s.remove(e)
+		w.componentRemoved(componentType, e)
 	}
+}
 
-	// Optimization: Find the component type with the fewest entities first.
-	var smallestMapSize = -1
-	var firstType reflect.Type
-	for _, ct := range componentTypes {
-		currentSize := w.getMapSizeForType(ct)
-		if smallestMapSize == -1 || currentSize < smallestMapSize {
-			smallestMapSize = currentSize
-			firstType = ct
+// Components returns every component an entity has, keyed by component type name
+// (e.g. "Health", "GuinsoosRagebladeEffect"). It is meant for debugging and inspection.
+func (w *World) Components(e entity.Entity) map[string]interface{} {
+	found := make(map[string]interface{})
+	for componentType, s := range w.stores {
+		if comp, ok := s.getAny(e); ok {
+			found[componentType.Name()] = comp
 		}
 	}
-
-	if smallestMapSize == 0 {
-		return []entity.Entity{} // If the smallest map is empty, no entities can have all components.
+	return found
+}
+
+// Clone returns a deep copy of the world: every store, with every component copied, and the
+// entity counter. Components that hold slices or maps copy them through their Clone method;
+// the rest are plain values. The copy shares nothing mutable with w, so a simulation can be
+// branched from it and continued independently.
+func (w *World) Clone() *World {
+	clone := &World{
+		nextEntityId: atomic.LoadUint32(&w.nextEntityId),
+		stores:       make(map[reflect.Type]storage, len(w.stores)),
+		views:        make(map[viewKey]*view),
+		viewsByType:  make(map[reflect.Type][]*view),
 	}
-
-	// Initialize candidates with entities from the smallest map
-	initialSet := w.getEntitiesForType(firstType)
-	candidates := make(map[entity.Entity]bool, len(initialSet))
-	for _, e := range initialSet {
-		candidates[e] = true
+	for componentType, s := range w.stores {
+		clone.stores[componentType] = s.clone()
 	}
+	return clone
+}
 
-	// Filter by the remaining component types
-	for _, ct := range componentTypes {
-		if ct == firstType { // Skip the type we already used
-			continue
-		}
-
-		// Check entities for the current component type
-		currentTypeSet := make(map[entity.Entity]bool)
-		for _, e := range w.getEntitiesForType(ct) {
-			currentTypeSet[e] = true
-		}
-
-		// Filter candidates: keep only those present in currentTypeSet
-		for e := range candidates {
-			if !currentTypeSet[e] {
-				delete(candidates, e) // Remove if entity.Entity doesn't have the current component type
-			}
-		}
-
-		// Early exit if no candidates remain
-		if len(candidates) == 0 {
-			return []entity.Entity{}
+// Entities returns every entity that has at least one component, in entity order.
+func (w *World) Entities() []entity.Entity {
+	seen := make(map[entity.Entity]bool)
+	for _, s := range w.stores {
+		for _, e := range s.entities() {
+			seen[e] = true
 		}
 	}
-
-	// Convert the final candidate map keys to a slice
-	result := make([]entity.Entity, 0, len(candidates))
-	for e := range candidates {
+	result := make([]entity.Entity, 0, len(seen))
+	for e := range seen {
 		result = append(result, e)
 	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
 	return result
 }
 
-// Helper to get map size for a type
-func (w *World) getMapSizeForType(componentType reflect.Type) int {
-	switch componentType {
-	case reflect.TypeOf(components.Health{}):
-		return len(w.Health)
-	case reflect.TypeOf(components.Mana{}):
-		return len(w.Mana)
-	case reflect.TypeOf(components.Attack{}):
-		return len(w.Attack)
-	case reflect.TypeOf(components.Traits{}):
-		return len(w.Traits)
-	case reflect.TypeOf(components.ChampionInfo{}):
-		return len(w.ChampionInfo)
-	case reflect.TypeOf(components.Position{}):
-		return len(w.Position)
-	case reflect.TypeOf(components.Team{}):
-		return len(w.Team)
-	case reflect.TypeOf(items.ItemStaticEffect{}):
-		return len(w.Item)
-	case reflect.TypeOf(components.Equipment{}):
-		return len(w.Equipment)
-	case reflect.TypeOf(components.CanAbilityCritFromTraits{}):
-		return len(w.CanAbilityCritFromTraits)
-	case reflect.TypeOf(components.CanAbilityCritFromItems{}):
-		return len(w.CanAbilityCritFromItems)
-	case reflect.TypeOf(components.Spell{}):
-		return len(w.Spell)
-	case reflect.TypeOf(components.Crit{}):
-		return len(w.Crit)
-	case reflect.TypeOf(components.State{}):
-		return len(w.State)
-	case reflect.TypeOf(components.DamageStats{}):
-		return len(w.DamageStats)
-	// --- Debuff Components ---
-	case reflect.TypeOf(debuffs.ShredEffect{}):
-		return len(w.ShredEffects)
-	case reflect.TypeOf(debuffs.SunderEffect{}):
-		return len(w.SunderEffects)
-	case reflect.TypeOf(debuffs.WoundEffect{}):
-		return len(w.WoundEffects)
-	case reflect.TypeOf(debuffs.BurnEffect{}):
-		return len(w.BurnEffects)
-	// --- Dynamic Item Effect Components ---
-	case reflect.TypeOf(items.ArchangelsStaffEffect{}):
-		return len(w.ArchangelsStaffEffects)
-	case reflect.TypeOf(items.QuicksilverEffect{}):
-		return len(w.QuicksilverEffects)
-	case reflect.TypeOf(items.TitansResolveEffect{}):
-		return len(w.TitansResolveEffects)
-	case reflect.TypeOf(items.GuinsoosRagebladeEffect{}):
-		return len(w.GuinsoosRagebladeEffects)
-	case reflect.TypeOf(items.SpiritVisageEffect{}):
-		return len(w.SpiritVisageEffects)
-	case reflect.TypeOf(items.KrakensFuryEffect{}):
-		return len(w.KrakensFuryEffects)
-	case reflect.TypeOf(items.SpearOfShojinEffect{}):
-		return len(w.SpearOfShojinEffects)
-	case reflect.TypeOf(items.BlueBuffEffect{}):
-		return len(w.BlueBuffEffects)
-	case reflect.TypeOf(items.FlickerbladeEffect{}):
-		return len(w.FlickerbladeEffects)
-	case reflect.TypeOf(items.NashorsToothEffect{}):
-		return len(w.NashorsToothEffects)
-	case reflect.TypeOf(items.VoidStaffEffect{}):
-		return len(w.VoidStaffEffects)
-	case reflect.TypeOf(items.RedBuffEffect{}):
-		return len(w.RedBuffEffects)
-	case reflect.TypeOf(items.EvenshroudEffect{}):
-		return len(w.EvenshroudEffects)
-	// Traits
-	case reflect.TypeOf(traits.RapidfireEffect{}):
-		return len(w.RapidfireEffects)
-	// Add cases for other component types...
-	default:
-		return 0
+// GetEntitiesWithComponents returns the entities that possess ALL the specified component types,
+// in entity order. Like Query2 and Query3, queries of up to four types are cached and kept
+// current by the world; callers must not modify the result.
+func (w *World) GetEntitiesWithComponents(componentTypes ...reflect.Type) []entity.Entity {
+	if len(componentTypes) == 0 {
+		return []entity.Entity{}
 	}
-}
 
-// Helper function for GetEntitiesWithComponents to get entities for a single type
-func (w *World) getEntitiesForType(componentType reflect.Type) []entity.Entity {
-	var entities []entity.Entity
-	switch componentType {
-	case reflect.TypeOf(components.Health{}):
-		entities = make([]entity.Entity, 0, len(w.Health))
-		for e := range w.Health {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.Mana{}):
-		entities = make([]entity.Entity, 0, len(w.Mana))
-		for e := range w.Mana {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.Attack{}):
-		entities = make([]entity.Entity, 0, len(w.Attack))
-		for e := range w.Attack {
-			entities = append(entities, e)
+	var key viewKey
+	cacheable := len(componentTypes) <= maxViewTypes
+	if cacheable {
+		copy(key[:], componentTypes)
+		if v := w.cachedView(key); v != nil {
+			return v.result()
 		}
-	case reflect.TypeOf(components.Traits{}):
-		entities = make([]entity.Entity, 0, len(w.Traits))
-		for e := range w.Traits {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.ChampionInfo{}):
-		entities = make([]entity.Entity, 0, len(w.ChampionInfo))
-		for e := range w.ChampionInfo {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.Position{}):
-		entities = make([]entity.Entity, 0, len(w.Position))
-		for e := range w.Position {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.Team{}):
-		entities = make([]entity.Entity, 0, len(w.Team))
-		for e := range w.Team {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.ItemStaticEffect{}):
-		entities = make([]entity.Entity, 0, len(w.Item))
-		for e := range w.Item {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.Equipment{}):
-		entities = make([]entity.Entity, 0, len(w.Equipment))
-		for e := range w.Equipment {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.CanAbilityCritFromTraits{}):
-		entities = make([]entity.Entity, 0, len(w.CanAbilityCritFromTraits))
-		for e := range w.CanAbilityCritFromTraits {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.CanAbilityCritFromItems{}):
-		entities = make([]entity.Entity, 0, len(w.CanAbilityCritFromItems))
-		for e := range w.CanAbilityCritFromItems {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.Spell{}):
-		entities = make([]entity.Entity, 0, len(w.Spell))
-		for e := range w.Spell {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.Crit{}):
-		entities = make([]entity.Entity, 0, len(w.Crit))
-		for e := range w.Crit {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.State{}):
-		entities = make([]entity.Entity, 0, len(w.State))
-		for e := range w.State {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(components.DamageStats{}):
-		entities = make([]entity.Entity, 0, len(w.DamageStats))
-		for e := range w.DamageStats {
-			entities = append(entities, e)
-		}
-	// --- Debuff Components ---
-	case reflect.TypeOf(debuffs.ShredEffect{}):
-		entities = make([]entity.Entity, 0, len(w.ShredEffects))
-		for e := range w.ShredEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(debuffs.SunderEffect{}):
-		entities = make([]entity.Entity, 0, len(w.SunderEffects))
-		for e := range w.SunderEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(debuffs.WoundEffect{}):
-		entities = make([]entity.Entity, 0, len(w.WoundEffects))
-		for e := range w.WoundEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(debuffs.BurnEffect{}):
-		entities = make([]entity.Entity, 0, len(w.BurnEffects))
-		for e := range w.BurnEffects {
-			entities = append(entities, e)
-		}
-	// --- Dynamic Item Effect Components ---
-	case reflect.TypeOf(items.ArchangelsStaffEffect{}):
-		entities = make([]entity.Entity, 0, len(w.ArchangelsStaffEffects))
-		for e := range w.ArchangelsStaffEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.QuicksilverEffect{}):
-		entities = make([]entity.Entity, 0, len(w.QuicksilverEffects))
-		for e := range w.QuicksilverEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.TitansResolveEffect{}):
-		entities = make([]entity.Entity, 0, len(w.TitansResolveEffects))
-		for e := range w.TitansResolveEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.GuinsoosRagebladeEffect{}):
-		entities = make([]entity.Entity, 0, len(w.GuinsoosRagebladeEffects))
-		for e := range w.GuinsoosRagebladeEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.SpiritVisageEffect{}):
-		entities = make([]entity.Entity, 0, len(w.SpiritVisageEffects))
-		for e := range w.SpiritVisageEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.KrakensFuryEffect{}):
-		entities = make([]entity.Entity, 0, len(w.KrakensFuryEffects))
-		for e := range w.KrakensFuryEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.SpearOfShojinEffect{}):
-		entities = make([]entity.Entity, 0, len(w.SpearOfShojinEffects))
-		for e := range w.SpearOfShojinEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.BlueBuffEffect{}):
-		entities = make([]entity.Entity, 0, len(w.BlueBuffEffects))
-		for e := range w.BlueBuffEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.FlickerbladeEffect{}):
-		entities = make([]entity.Entity, 0, len(w.FlickerbladeEffects))
-		for e := range w.FlickerbladeEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.NashorsToothEffect{}):
-		entities = make([]entity.Entity, 0, len(w.NashorsToothEffects))
-		for e := range w.NashorsToothEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.VoidStaffEffect{}):
-		entities = make([]entity.Entity, 0, len(w.VoidStaffEffects))
-		for e := range w.VoidStaffEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.RedBuffEffect{}):
-		entities = make([]entity.Entity, 0, len(w.RedBuffEffects))
-		for e := range w.RedBuffEffects {
-			entities = append(entities, e)
-		}
-	case reflect.TypeOf(items.EvenshroudEffect{}):
-		entities = make([]entity.Entity, 0, len(w.EvenshroudEffects))
-		for e := range w.EvenshroudEffects {
-			entities = append(entities, e)
-		}
-	// Traits
-	case reflect.TypeOf(traits.RapidfireEffect{}):
-		entities = make([]entity.Entity, 0, len(w.RapidfireEffects))
-		for e := range w.RapidfireEffects {
-			entities = append(entities, e)
+	}
+
+	stores := make([]storage, 0, len(componentTypes))
+	for _, ct := range componentTypes {
+		s, ok := w.stores[ct]
+		if !ok {
+			newStore, registered := storeConstructor(ct)
+			if !registered {
+				return []entity.Entity{} // No entity can have an unknown component.
+			}
+			s = newStore()
+			w.stores[ct] = s
 		}
-	// Add cases for other component types...
-	default:
-		return []entity.Entity{} // Return empty slice for unknown types
+		stores = append(stores, s)
+	}
+	if !cacheable {
+		return filterEntities(smallestStore(stores), stores...)
 	}
-	return entities
+	return w.addView(key, stores...).result()
 }
 
-// --- Type-Safe Getters (Recommended) ---
+// --- Type-Safe Getters ---
+// Shorthands for Get[T] on the built-in components, kept for existing callers.
 
 // GetHealth returns the Health component for an entity.Entity, type-safe.
 func (w *World) GetHealth(e entity.Entity) (*components.Health, bool) {
-	comp, ok := w.Health[e]
-	return comp, ok
+	return Get[components.Health](w, e)
 }
 
 // GetMana returns the Mana component for an entity.Entity, type-safe.
 func (w *World) GetMana(e entity.Entity) (*components.Mana, bool) {
-	comp, ok := w.Mana[e]
-	return comp, ok
+	return Get[components.Mana](w, e)
 }
 
 // GetAttack returns the Attack component for an entity.Entity, type-safe.
 func (w *World) GetAttack(e entity.Entity) (*components.Attack, bool) {
-	comp, ok := w.Attack[e]
-	return comp, ok
+	return Get[components.Attack](w, e)
 }
 
 // GetTraits returns the Traits component for an entity.Entity, type-safe.
 func (w *World) GetTraits(e entity.Entity) (*components.Traits, bool) {
-	comp, ok := w.Traits[e]
-	return comp, ok
+	return Get[components.Traits](w, e)
 }
 
 // GetChampionInfo returns the ChampionInfo component for an entity.Entity, type-safe.
 func (w *World) GetChampionInfo(e entity.Entity) (*components.ChampionInfo, bool) {
-	comp, ok := w.ChampionInfo[e]
-	return comp, ok
+	return Get[components.ChampionInfo](w, e)
 }
 
 // GetPosition returns the Position component for an entity.Entity, type-safe.
 func (w *World) GetPosition(e entity.Entity) (*components.Position, bool) {
-	comp, ok := w.Position[e]
-	return comp, ok
+	return Get[components.Position](w, e)
 }
 
 // GetTeam returns the Team component for an entity.Entity, type-safe.
 func (w *World) GetTeam(e entity.Entity) (*components.Team, bool) {
-	comp, ok := w.Team[e]
-	return comp, ok
+	return Get[components.Team](w, e)
 }
 
 // GetChampionByName returns the first entity.Entity with the specified champion name.
 func (w *World) GetChampionByName(name string) (entity.Entity, bool) {
-	for e, info := range w.ChampionInfo {
-		if info.Name == name {
+	for _, e := range Query[components.ChampionInfo](w) {
+		if info, _ := Get[components.ChampionInfo](w, e); info.Name == name {
 			return e, true
 		}
 	}
@@ -880,159 +253,142 @@ func (w *World) GetChampionByName(name string) (entity.Entity, bool) {
 
 // GetItemEffect returns the ItemEffect component for an entity.Entity, type-safe.
 func (w *World) GetItemEffect(e entity.Entity) (*items.ItemStaticEffect, bool) {
-	comp, ok := w.Item[e]
-	return comp, ok
+	return Get[items.ItemStaticEffect](w, e)
 }
 
 // GetEquipment returns the Equipment component for an entity.Entity, type-safe.
 func (w *World) GetEquipment(e entity.Entity) (*components.Equipment, bool) {
-	comp, ok := w.Equipment[e]
-	return comp, ok
+	return Get[components.Equipment](w, e)
 }
 
 // GetCanAbilityCritFromTraits returns the CanAbilityCritFromTraits component for an entity.Entity, type-safe.
 func (w *World) GetCanAbilityCritFromTraits(e entity.Entity) (*components.CanAbilityCritFromTraits, bool) {
-	comp, ok := w.CanAbilityCritFromTraits[e]
-	return comp, ok
+	return Get[components.CanAbilityCritFromTraits](w, e)
 }
 
 // GetCanAbilityCritFromItems returns the CanAbilityCritFromItems component for an entity.Entity, type-safe.
 func (w *World) GetCanAbilityCritFromItems(e entity.Entity) (*components.CanAbilityCritFromItems, bool) {
-	comp, ok := w.CanAbilityCritFromItems[e]
-	return comp, ok
+	return Get[components.CanAbilityCritFromItems](w, e)
 }
 
 // GetSpell returns the Spell component for an entity.Entity, type-safe.
 func (w *World) GetSpell(e entity.Entity) (*components.Spell, bool) {
-	comp, ok := w.Spell[e]
-	return comp, ok
+	return Get[components.Spell](w, e)
 }
 
 // GetCrit returns the Crit component for an entity.Entity, type-safe.
 func (w *World) GetCrit(e entity.Entity) (*components.Crit, bool) {
-	comp, ok := w.Crit[e]
-	return comp, ok
+	return Get[components.Crit](w, e)
 }
 
 // GetState returns the State component for an entity.Entity, type-safe.
 func (w *World) GetState(e entity.Entity) (*components.State, bool) {
-	comp, ok := w.State[e]
-	return comp, ok
+	return Get[components.State](w, e)
 }
 
 // GetDamageStats returns the DamageStats component for an entity.Entity, type-safe.
 func (w *World) GetDamageStats(e entity.Entity) (*components.DamageStats, bool) {
-	comp, ok := w.DamageStats[e]
-	return comp, ok
+	return Get[components.DamageStats](w, e)
+}
+
+// GetEffectUptime returns the EffectUptime component for an entity.Entity, type-safe.
+func (w *World) GetEffectUptime(e entity.Entity) (*components.EffectUptime, bool) {
+	return Get[components.EffectUptime](w, e)
+}
+
+// GetDefenseStats returns the DefenseStats component for an entity.Entity, type-safe.
+func (w *World) GetDefenseStats(e entity.Entity) (*components.DefenseStats, bool) {
+	return Get[components.DefenseStats](w, e)
 }
 
 // GetArchangelsStaffEffect returns the ArchangelsEffect component for an entity.Entity, type-safe.
 func (w *World) GetArchangelsStaffEffect(e entity.Entity) (*items.ArchangelsStaffEffect, bool) {
-	comp, ok := w.ArchangelsStaffEffects[e]
-	return comp, ok
+	return Get[items.ArchangelsStaffEffect](w, e)
 }
 
 // GetQuicksilverEffect returns the QuicksilverEffect component for an entity.Entity, type-safe.
 func (w *World) GetQuicksilverEffect(e entity.Entity) (*items.QuicksilverEffect, bool) {
-	comp, ok := w.QuicksilverEffects[e]
-	return comp, ok
+	return Get[items.QuicksilverEffect](w, e)
 }
 
 // GetTitansResolveEffect returns the TitansResolveEffect component for an entity.Entity, type-safe.
 func (w *World) GetTitansResolveEffect(e entity.Entity) (*items.TitansResolveEffect, bool) {
-	comp, ok := w.TitansResolveEffects[e]
-	return comp, ok
+	return Get[items.TitansResolveEffect](w, e)
 }
 
 // GetGuinsoosRagebladeEffect returns the GuinsoosRagebladeEffect component for an entity.Entity, type-safe.
 func (w *World) GetGuinsoosRagebladeEffect(e entity.Entity) (*items.GuinsoosRagebladeEffect, bool) {
-	comp, ok := w.GuinsoosRagebladeEffects[e]
-	return comp, ok
+	return Get[items.GuinsoosRagebladeEffect](w, e)
 }
 
 // GetSpiritVisageEffect returns the SpiritVisageEffect component for an entity.Entity, type-safe.
 func (w *World) GetSpiritVisageEffect(e entity.Entity) (*items.SpiritVisageEffect, bool) {
-	comp, ok := w.SpiritVisageEffects[e]
-	return comp, ok
+	return Get[items.SpiritVisageEffect](w, e)
 }
 
 // GetKrakensFuryEffect returns the KrakensFuryEffect component for an entity.Entity, type-safe.
-func (w *World) GetKrakensFuryEffect(e entity.Entity) (*items.
-	KrakensFuryEffect, bool) {
-	comp, ok := w.KrakensFuryEffects[e]
-	return comp, ok
+func (w *World) GetKrakensFuryEffect(e entity.Entity) (*items.KrakensFuryEffect, bool) {
+	return Get[items.KrakensFuryEffect](w, e)
 }
 
 // GetSpearOfShojinEffect returns the SpearOfShojinEffect component for an entity.Entity, type-safe.
 func (w *World) GetSpearOfShojinEffect(e entity.Entity) (*items.SpearOfShojinEffect, bool) {
-	comp, exists := w.SpearOfShojinEffects[e]
-	return comp, exists
+	return Get[items.SpearOfShojinEffect](w, e)
 }
 
 // GetBlueBuffEffect returns the BlueBuffEffect component for an entity.Entity, type-safe.
 func (w *World) GetBlueBuffEffect(e entity.Entity) (*items.BlueBuffEffect, bool) {
-	comp, ok := w.BlueBuffEffects[e]
-	return comp, ok
+	return Get[items.BlueBuffEffect](w, e)
 }
 
 // GetFlickerbladeEffect returns the FlickerbladeEffect component for an entity.Entity, type-safe.
 func (w *World) GetFlickerbladeEffect(e entity.Entity) (*items.FlickerbladeEffect, bool) {
-	comp, ok := w.FlickerbladeEffects[e]
-	return comp, ok
+	return Get[items.FlickerbladeEffect](w, e)
 }
 
 // GetNashorsToothEffect returns the NashorsToothEffect component for an entity, type-safe.
 func (w *World) GetNashorsToothEffect(e entity.Entity) (*items.NashorsToothEffect, bool) {
-	effect, ok := w.NashorsToothEffects[e]
-	return effect, ok
+	return Get[items.NashorsToothEffect](w, e)
 }
 
 // GetVoidStaffEffect returns the VoidStaffEffect component for an entity.Entity, type-safe.
 func (w *World) GetVoidStaffEffect(e entity.Entity) (*items.VoidStaffEffect, bool) {
-	comp, ok := w.VoidStaffEffects[e]
-	return comp, ok
+	return Get[items.VoidStaffEffect](w, e)
 }
 
 // GetRedBuffEffect returns the RedBuffEffect component for an entity.Entity, type-safe.
 func (w *World) GetRedBuffEffect(e entity.Entity) (*items.RedBuffEffect, bool) {
-	comp, ok := w.RedBuffEffects[e]
-	return comp, ok
+	return Get[items.RedBuffEffect](w, e)
 }
 
 // GetEvenshroudEffect returns the EvenshroudEffect component for an entity.Entity, type-safe.
 func (w *World) GetEvenshroudEffect(e entity.Entity) (*items.EvenshroudEffect, bool) {
-	comp, ok := w.EvenshroudEffects[e]
-	return comp, ok
+	return Get[items.EvenshroudEffect](w, e)
 }
 
 // Traits
 // GetRapidfireEffect returns the RapidfireEffect component for an entity.Entity, type-safe.
 func (w *World) GetRapidfireEffect(e entity.Entity) (*traits.RapidfireEffect, bool) {
-	comp, ok := w.RapidfireEffects[e]
-	return comp, ok
+	return Get[traits.RapidfireEffect](w, e)
 }
 
 // Debuffs
 // GetShredEffect returns the ShredEffect component for an entity, type-safe.
 func (w *World) GetShredEffect(e entity.Entity) (*debuffs.ShredEffect, bool) {
-	comp, ok := w.ShredEffects[e]
-	return comp, ok
+	return Get[debuffs.ShredEffect](w, e)
 }
 
 // GetSunderEffect returns the SunderEffect component for an entity, type-safe.
 func (w *World) GetSunderEffect(e entity.Entity) (*debuffs.SunderEffect, bool) {
-	comp, ok := w.SunderEffects[e]
-	return comp, ok
+	return Get[debuffs.SunderEffect](w, e)
 }
 
 // GetWoundEffect returns the WoundEffect component for an entity, type-safe.
 func (w *World) GetWoundEffect(e entity.Entity) (*debuffs.WoundEffect, bool) {
-	comp, ok := w.WoundEffects[e]
-	return comp, ok
+	return Get[debuffs.WoundEffect](w, e)
 }
 
 // GetBurnEffect returns the BurnEffect component for an entity, type-safe.
 func (w *World) GetBurnEffect(e entity.Entity) (*debuffs.BurnEffect, bool) {
-	comp, ok := w.BurnEffects[e]
-	return comp, ok
+	return Get[debuffs.BurnEffect](w, e)
 }
@@ -1,6 +1,10 @@
 package ecs_test
 
 import (
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/ecs"
+	"tft-dps-simulator/internal/core/entity"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -9,4 +13,55 @@ var _ = Describe("World", func() {
 	It("should say hello", func() {
 		Expect("Hello, World!").To(Equal("Hello, World!"))
 	})
+
+	It("lists entities and their components for inspection", func() {
+		world := ecs.NewWorld()
+		first := world.NewEntity()
+		second := world.NewEntity()
+		health := components.NewHealth(500, 30, 30)
+		team := components.Team{ID: 1}
+		Expect(world.AddComponent(second, &team)).To(Succeed())
+		Expect(world.AddComponent(first, health)).To(Succeed())
+		Expect(world.AddComponent(first, &team)).To(Succeed())
+
+		Expect(world.Entities()).To(Equal([]entity.Entity{first, second}))
+		Expect(world.Components(first)).To(Equal(map[string]interface{}{"Health": health, "Team": &team}))
+		Expect(world.Components(second)).To(HaveLen(1))
+		Expect(world.Components(entity.Entity(99))).To(BeEmpty())
+	})
+})
+
+var _ = Describe("World.Clone", func() {
+	It("copies every component so the copy can change independently", func() {
+		world := ecs.NewWorld()
+		champ := world.NewEntity()
+		traits := components.NewTraits([]string{"Rapidfire"})
+		uptime := components.NewEffectUptime()
+		uptime.StartEffect(components.UptimeQuicksilver, 1.0)
+		Expect(world.AddComponent(champ, components.NewHealth(500, 30, 30))).To(Succeed())
+		Expect(world.AddComponent(champ, &traits)).To(Succeed())
+		Expect(world.AddComponent(champ, uptime)).To(Succeed())
+		Expect(world.AddComponent(champ, components.NewEquipment())).To(Succeed())
+
+		clone := world.Clone()
+		Expect(clone.Components(champ)).To(Equal(world.Components(champ)))
+
+		cloneHealth, _ := clone.GetHealth(champ)
+		cloneHealth.SetCurrentHP(1)
+		cloneTraits, _ := clone.GetTraits(champ)
+		cloneTraits.AddTrait("Marksman")
+		cloneUptime, _ := clone.GetEffectUptime(champ)
+		cloneUptime.EndEffect(components.UptimeQuicksilver, 3.0)
+		cloneEquipment, _ := clone.GetEquipment(champ)
+		cloneEquipment.Items = append(cloneEquipment.Items, nil)
+
+		health, _ := world.GetHealth(champ)
+		Expect(health.GetCurrentHP()).To(Equal(500.0))
+		Expect(traits.GetTraits()).To(Equal([]string{"Rapidfire"}))
+		Expect(uptime.IsEffectActive(components.UptimeQuicksilver)).To(BeTrue())
+		equipment, _ := world.GetEquipment(champ)
+		Expect(equipment.Items).To(BeEmpty())
+
+		Expect(clone.NewEntity()).To(Equal(world.NewEntity()))
+	})
 })
@@ -13,13 +13,15 @@ import (
 
 // ChampionFactory creates champion entities from champion data.
 type ChampionFactory struct {
-	world *ecs.World
+	world   *ecs.World
+	dataSet *data.DataSet // Champion definitions
 }
 
-// NewChampionFactory creates a new ChampionFactory.
-func NewChampionFactory(world *ecs.World) *ChampionFactory {
+// NewChampionFactory creates a new ChampionFactory that looks champions up in dataSet.
+func NewChampionFactory(world *ecs.World, dataSet *data.DataSet) *ChampionFactory {
 	return &ChampionFactory{
-		world: world,
+		world:   world,
+		dataSet: dataSet,
 	}
 }
 
@@ -156,6 +158,11 @@ func (cf *ChampionFactory) CreateChampion(championData data.Champion, starLevel
 		return 0, fmt.Errorf("failed to add DamageStats component to %s: %w", championData.Name, err)
 	}
 
+	err = cf.world.AddComponent(entity, components.NewDefenseStats())
+	if err != nil {
+		return 0, fmt.Errorf("failed to add DefenseStats component to %s: %w", championData.Name, err)
+	}
+
 	// If we reached here, all essential components were added successfully
 	return entity, nil
 }
@@ -163,8 +170,8 @@ func (cf *ChampionFactory) CreateChampion(championData data.Champion, starLevel
 // CreateChampionByApiName creates a champion entity by searching for it by name.
 // It now propagates errors from CreateChampion.
 func (cf *ChampionFactory) CreateChampionByApiName(apiName string, starLevel int, team int) (entity.Entity, error) {
-	// Find champion data by name using the function from the data package
-	championData := data.GetChampionByApiName(apiName)
+	// Find champion data by name in the factory's data set
+	championData := cf.dataSet.GetChampionByApiName(apiName)
 	if championData == nil {
 		return 0, fmt.Errorf("champion data for '%s' not found", apiName)
 	}
@@ -25,23 +25,23 @@ var _ = Describe("EquipmentManager", func() {
 	)
 	BeforeEach(func() {
 		world = ecs.NewWorld()
-		championFactory := factory.NewChampionFactory(world)
-		equipmentManager = managers.NewEquipmentManager(world)
+		championFactory := factory.NewChampionFactory(world, testData)
+		equipmentManager = managers.NewEquipmentManager(world, testData)
 
 		// Create a champion entity with necessary components
 		champion, _ = championFactory.CreatePlayerChampion("TFT_BlueGolem", 1)
 
 		// Get some item data for testing
-		bfSword = data.GetItemByApiName("TFT_Item_BFSword")
+		bfSword = testData.GetItemByApiName("TFT_Item_BFSword")
 		Expect(bfSword).NotTo(BeNil(), "BF Sword item data should be loaded")
-		deathblade = data.GetItemByApiName("TFT_Item_Deathblade") // Assuming Deathblade is unique
+		deathblade = testData.GetItemByApiName("TFT_Item_Deathblade") // Assuming Deathblade is unique
 		Expect(deathblade).NotTo(BeNil(), "Deathblade item data should be loaded")
 		Expect(deathblade.Unique).To(BeFalse(), "Deathblade should be marked as unique for this test") // Verify assumption
-		tear = data.GetItemByApiName("TFT_Item_TearOfTheGoddess")
+		tear = testData.GetItemByApiName("TFT_Item_TearOfTheGoddess")
 		Expect(tear).NotTo(BeNil(), "Tear item data should be loaded")
-		inifityEdge = data.GetItemByApiName("TFT_Item_InfinityEdge")
+		inifityEdge = testData.GetItemByApiName("TFT_Item_InfinityEdge")
 		Expect(inifityEdge).NotTo(BeNil(), "Infinity Edge item data should be loaded")
-		bluebuff = data.GetItemByApiName("TFT_Item_BlueBuff")
+		bluebuff = testData.GetItemByApiName("TFT_Item_BlueBuff")
 		Expect(bluebuff).NotTo(BeNil(), "Blue Buff item data should be loaded")
 
 	})
@@ -87,6 +87,16 @@ var _ = Describe("EquipmentManager", func() {
 			})
 		})
 
+		Context("when adding a dynamic item", func() {
+			It("should add the effect component built by the item's handler", func() {
+				Expect(equipmentManager.AddItemToChampion(champion, bluebuff.ApiName)).To(Succeed())
+
+				effect, ok := world.GetBlueBuffEffect(champion)
+				Expect(ok).To(BeTrue())
+				Expect(effect).NotTo(BeNil())
+			})
+		})
+
 		Context("when adding an item to full equipment", func() {
 			BeforeEach(func() {
 				// Fill equipment slots
@@ -199,6 +209,68 @@ var _ = Describe("EquipmentManager", func() {
 			})
 		})
 
+		Context("when removing a dynamic item", func() {
+			It("should remove the effect component built by the item's handler", func() {
+				Expect(equipmentManager.AddItemToChampion(champion, bluebuff.ApiName)).To(Succeed())
+				Expect(equipmentManager.RemoveItemFromChampion(champion, bluebuff.ApiName)).To(Succeed())
+
+				_, ok := world.GetBlueBuffEffect(champion)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		Context("when removing one of two stacking items", func() {
+			It("should reverse the stacked bonus only once", func() {
+				Expect(equipmentManager.AddItemToChampion(champion, data.TFT_Item_GuinsoosRageblade)).To(Succeed())
+				Expect(equipmentManager.AddItemToChampion(champion, data.TFT_Item_GuinsoosRageblade)).To(Succeed())
+
+				attack, ok := world.GetAttack(champion)
+				Expect(ok).To(BeTrue())
+				baseBonusAS := attack.GetBonusPercentAttackSpeed()
+
+				// Stack the shared effect the way the Rageblade ticks do during combat
+				effect, ok := world.GetGuinsoosRagebladeEffect(champion)
+				Expect(ok).To(BeTrue())
+				effect.SetStacks(4)
+				attack.AddBonusPercentAttackSpeed(effect.GetCurrentBonusAS())
+
+				Expect(equipmentManager.RemoveItemFromChampion(champion, data.TFT_Item_GuinsoosRageblade)).To(Succeed())
+				Expect(attack.GetBonusPercentAttackSpeed()).To(BeNumerically("~", baseBonusAS))
+				_, ok = world.GetGuinsoosRagebladeEffect(champion)
+				Expect(ok).To(BeTrue(), "the remaining Rageblade keeps its effect component")
+
+				Expect(equipmentManager.RemoveItemFromChampion(champion, data.TFT_Item_GuinsoosRageblade)).To(Succeed())
+				Expect(attack.GetBonusPercentAttackSpeed()).To(BeNumerically("~", baseBonusAS))
+			})
+		})
+
+		Context("when removing Nashor's Tooth while its buff is active", func() {
+			It("should reverse the whole attack speed bonus", func() {
+				Expect(equipmentManager.AddItemToChampion(champion, data.TFT_Item_NashorsTooth)).To(Succeed())
+				Expect(equipmentManager.AddItemToChampion(champion, data.TFT_Item_NashorsTooth)).To(Succeed())
+
+				attack, ok := world.GetAttack(champion)
+				Expect(ok).To(BeTrue())
+				baseBonusAS := attack.GetBonusPercentAttackSpeed()
+
+				// Activate the buff the way a landed spell does during combat, for both copies
+				effect, ok := world.GetNashorsToothEffect(champion)
+				Expect(ok).To(BeTrue())
+				effect.ActivateBuff(3.0)
+				attack.AddBonusPercentAttackSpeed(effect.GetBonusAS() * 2)
+				Expect(effect.IsBuffActive()).To(BeTrue())
+
+				Expect(equipmentManager.RemoveItemFromChampion(champion, data.TFT_Item_NashorsTooth)).To(Succeed())
+				Expect(attack.GetBonusPercentAttackSpeed()).To(BeNumerically("~", baseBonusAS))
+				effect, ok = world.GetNashorsToothEffect(champion)
+				Expect(ok).To(BeTrue(), "the remaining Nashor's Tooth keeps its effect component")
+				Expect(effect.IsBuffActive()).To(BeFalse())
+
+				Expect(equipmentManager.RemoveItemFromChampion(champion, data.TFT_Item_NashorsTooth)).To(Succeed())
+				Expect(attack.GetBonusPercentAttackSpeed()).To(BeNumerically("~", baseBonusAS))
+			})
+		})
+
 		Context("when removing an item that is not equipped", func() {
 			It("should return an error", func() {
 				err := equipmentManager.RemoveItemFromChampion(champion, deathblade.ApiName) // Deathblade was not added
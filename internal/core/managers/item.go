@@ -2,7 +2,6 @@ package managers
 
 import (
 	"log"
-	"reflect"
 
 	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/ecs"
@@ -29,7 +28,7 @@ func NewItemManager(world *ecs.World, bus eventsys.EventBus) *ItemManager {
 // EnqueueInitialEvents checks equipped items and schedules the first timer events.
 func (im *ItemManager) EnqueueInitialEvents() {
 	log.Printf("DEBUG: EnqueueInitialEvents called in ItemManager")
-	entities := im.world.GetEntitiesWithComponents(reflect.TypeOf(components.Equipment{}))
+	entities := ecs.Query[components.Equipment](im.world)
 
 	for _, entity := range entities {
 		equipment, ok := im.world.GetEquipment(entity)
@@ -53,23 +52,15 @@ func (im *ItemManager) EnqueueInitialEvents() {
 	}
 }
 
-// CanHandle checks if the ItemSystem should process this event.
-// It should generally try to handle events that item handlers might be interested in.
-func (im *ItemManager) CanHandle(event interface{}) bool {
-	// ItemSystem should attempt to process any event, and then delegate.
-	// Specific item handlers will decide if they actually act on the event.
-	// This is a broad catch-all; specific event types can be checked if performance is a concern.
-	switch event.(type) {
-	// Item-specific tick/proc events (examples)
-	case eventsys.ArchangelsTickEvent, eventsys.QuicksilverProcEvent, eventsys.QuicksilverEndEvent, eventsys.GuinsoosRagebladeTickEvent, eventsys.EvenshroudResistActivateEvent, eventsys.EvenshroudResistDeactivateEvent:
-		return true
-		
-	// General game events that items might react to
-	case eventsys.AttackLandedEvent, eventsys.DamageAppliedEvent, eventsys.SpellLandedEvent, eventsys.KillEvent, eventsys.AssistEvent:
-		return true
-	default:
-		return false
-	}
+// Subscriptions lists the events item handlers might be interested in: item-specific tick and
+// proc events, and general game events that items react to. Specific item handlers decide
+// whether they actually act on the event.
+func (im *ItemManager) Subscriptions() []eventsys.Subscription {
+	return eventsys.Subscribe(eventsys.PriorityDefault,
+		eventsys.ArchangelsTickEvent{}, eventsys.QuicksilverProcEvent{}, eventsys.QuicksilverEndEvent{},
+		eventsys.GuinsoosRagebladeTickEvent{}, eventsys.EvenshroudResistActivateEvent{}, eventsys.EvenshroudResistDeactivateEvent{},
+		eventsys.AttackLandedEvent{}, eventsys.DamageAppliedEvent{}, eventsys.SpellLandedEvent{}, eventsys.KillEvent{}, eventsys.AssistEvent{},
+	)
 }
 
 // HandleEvent dispatches the event to relevant item handlers.
@@ -17,14 +17,16 @@ import (
 // It handles trait effects triggered by game events.
 type TraitManager struct {
     world      *ecs.World
+    dataSet    *data.DataSet // Trait definitions passed to handlers on activation
     traitState *traitsys.TeamTraitState
     eventBus   eventsys.EventBus
 }
 
 // NewTraitManager creates a new DynamicTraitSystem.
-func NewTraitManager(world *ecs.World, state *traitsys.TeamTraitState, bus eventsys.EventBus) *TraitManager {
+func NewTraitManager(world *ecs.World, dataSet *data.DataSet, state *traitsys.TeamTraitState, bus eventsys.EventBus) *TraitManager {
     return &TraitManager{
         world:      world,
+        dataSet:    dataSet,
         traitState: state,
         eventBus:   bus, // Store the event bus
     }
@@ -51,8 +53,8 @@ func (s *TraitManager) ActivateTraits() {
             }
 
             // Get trait data and call OnActivate
-            traitData, exists := data.Traits[traitName]
-            if exists && currentTierIndex < len(traitData.Effects) {
+            traitData := s.dataSet.GetTraitByName(traitName)
+            if traitData != nil && currentTierIndex < len(traitData.Effects) {
                 activeEffect := traitData.Effects[currentTierIndex]
                 log.Printf("DynamicTraitSystem (ActivateTraits): Activating dynamic event trait '%s' for Team %d (TierIndex %d)", traitName, teamID, currentTierIndex)
                 // OnActivate is responsible for adding components or applying initial effects
@@ -129,12 +131,9 @@ func (s *TraitManager) determineInvolvedEntities(event interface{}) []entity.Ent
     return result
 }
 
-// CanHandle checks if the system should process this event type.
-func (s *TraitManager) CanHandle(evt interface{}) bool {
-    switch evt.(type) {
-    case eventsys.AttackLandedEvent:
-        return true
-    default:
-        return false
-    }
+// Subscriptions lists the events the manager processes.
+func (s *TraitManager) Subscriptions() []eventsys.Subscription {
+    return eventsys.Subscribe(eventsys.PriorityTraits,
+        eventsys.AttackLandedEvent{},
+    )
 }
\ No newline at end of file
@@ -21,6 +21,12 @@ type SimulationConfig struct {
 	// Performance settings
 	MaxEntities        int  // Upper limit on entities for memory pre-allocation
 	ParallelProcessing bool // Whether to use goroutines for system updates
+
+	// Jitter randomizes the order of events at the same timestamp, for Monte Carlo runs. Without it
+	// they are ordered by phase and enqueue order, and every run is the same.
+	Jitter bool
+	// Seed for the event ordering jitter; runs with the same non-zero seed are reproducible (0 picks a random seed)
+	Seed int64
 }
 
 // DefaultConfig returns a configuration with sensible defaults
@@ -71,6 +77,18 @@ func (c SimulationConfig) WithDebugMode(enabled bool) SimulationConfig {
 	return c
 }
 
+// WithSeed returns a copy of the config with the event ordering seed set
+func (c SimulationConfig) WithSeed(seed int64) SimulationConfig {
+	c.Seed = seed
+	return c
+}
+
+// WithJitter returns a copy of the config with event ordering jitter enabled or disabled
+func (c SimulationConfig) WithJitter(enabled bool) SimulationConfig {
+	c.Jitter = enabled
+	return c
+}
+
 // WithReportingInterval returns a copy of the config with updated reporting interval
 func (c SimulationConfig) WithReportingInterval(interval float64) SimulationConfig {
 	c.ReportingInterval = interval
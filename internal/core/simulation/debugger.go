@@ -0,0 +1,257 @@
+package simulation
+
+import (
+	"fmt"
+	"strings"
+
+	"tft-dps-simulator/internal/core/ecs"
+	"tft-dps-simulator/internal/core/entity"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+	"tft-dps-simulator/internal/core/utils"
+)
+
+// StopReason tells why a Debugger stopped running events.
+type StopReason string
+
+const (
+	StopStep       StopReason = "step"       // Ran the requested number of events
+	StopTime       StopReason = "time"       // The next event is after the requested time
+	StopBreakpoint StopReason = "breakpoint" // The next event matches a breakpoint
+	StopFinished   StopReason = "finished"   // The queue is empty or the next event is past MaxTime
+)
+
+// Breakpoint stops a Debugger before it dispatches a matching event. EventType is the unversioned
+// event type name ("AttackLandedEvent"); an empty EventType or a zero Entity matches anything.
+type Breakpoint struct {
+	ID        int
+	EventType string
+	Entity    entity.Entity
+}
+
+// Matches reports whether evt should stop the debugger.
+func (b Breakpoint) Matches(evt interface{}) bool {
+	if b.EventType != "" && !strings.HasPrefix(eventsys.EventTypeName(evt), b.EventType+".v") {
+		return false
+	}
+	if b.Entity == 0 {
+		return true
+	}
+	for _, e := range eventsys.EventEntities(evt) {
+		if e == b.Entity {
+			return true
+		}
+	}
+	return false
+}
+
+func (b Breakpoint) String() string {
+	switch {
+	case b.EventType != "" && b.Entity != 0:
+		return fmt.Sprintf("#%d %s on entity %d", b.ID, b.EventType, b.Entity)
+	case b.EventType != "":
+		return fmt.Sprintf("#%d %s", b.ID, b.EventType)
+	case b.Entity != 0:
+		return fmt.Sprintf("#%d any event on entity %d", b.ID, b.Entity)
+	default:
+		return fmt.Sprintf("#%d any event", b.ID)
+	}
+}
+
+// Stop describes where a Debugger run ended.
+type Stop struct {
+	Reason     StopReason
+	Processed  int         // Events dispatched by this run
+	Breakpoint *Breakpoint // The breakpoint hit, for StopBreakpoint
+}
+
+// Debugger steps a Simulation through its event loop one event at a time instead of running it
+// to the end, so the world and the pending queue can be inspected between events. Events are
+// dispatched exactly as RunSimulation would; the simulation must not be run separately.
+type Debugger struct {
+	sim              *Simulation
+	bus              *eventsys.SimpleBus
+	breakpoints      []Breakpoint
+	nextBreakpointID int
+	processed        int
+	finished         bool
+}
+
+// NewDebugger creates a debugger for a simulation that has not been run yet.
+func NewDebugger(sim *Simulation) (*Debugger, error) {
+	simpleBus, ok := sim.eventBus.(*eventsys.SimpleBus)
+	if !ok {
+		return nil, fmt.Errorf("EventBus is not a *SimpleBus, cannot debug")
+	}
+	return &Debugger{sim: sim, bus: simpleBus, nextBreakpointID: 1}, nil
+}
+
+// Fork returns a debugger on an independent copy of the simulation, paused at the same event and
+// with the same breakpoints, so a fight can be continued down two paths from here.
+func (d *Debugger) Fork() (*Debugger, error) {
+	clone, err := d.sim.Clone()
+	if err != nil {
+		return nil, err
+	}
+	fork, err := NewDebugger(clone)
+	if err != nil {
+		return nil, err
+	}
+	fork.breakpoints = d.Breakpoints()
+	fork.nextBreakpointID = d.nextBreakpointID
+	fork.processed = d.processed
+	fork.finished = d.finished
+	return fork, nil
+}
+
+// AddBreakpoint stops later runs before any event of eventType that refers to e.
+// Pass "" or 0 to leave either condition out.
+func (d *Debugger) AddBreakpoint(eventType string, e entity.Entity) (Breakpoint, error) {
+	if eventType == "" && e == 0 {
+		return Breakpoint{}, fmt.Errorf("breakpoint needs an event type or an entity")
+	}
+	if eventType != "" && !isEventType(eventType) {
+		return Breakpoint{}, fmt.Errorf("unknown event type %q", eventType)
+	}
+	bp := Breakpoint{ID: d.nextBreakpointID, EventType: eventType, Entity: e}
+	d.nextBreakpointID++
+	d.breakpoints = append(d.breakpoints, bp)
+	return bp, nil
+}
+
+// RemoveBreakpoint deletes a breakpoint by ID and reports whether it existed.
+func (d *Debugger) RemoveBreakpoint(id int) bool {
+	for i, bp := range d.breakpoints {
+		if bp.ID == id {
+			d.breakpoints = append(d.breakpoints[:i], d.breakpoints[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Breakpoints returns the active breakpoints in the order they were added.
+func (d *Debugger) Breakpoints() []Breakpoint {
+	return append([]Breakpoint(nil), d.breakpoints...)
+}
+
+// Step dispatches the next event, ignoring breakpoints, and returns it.
+// It returns nil once the simulation has finished.
+func (d *Debugger) Step() *eventsys.EventItem {
+	if d.finished {
+		return nil
+	}
+	item, ok := d.sim.stepEvent(d.bus)
+	if item != nil {
+		d.processed++
+	}
+	if !ok || d.bus.Len() == 0 {
+		d.finish()
+	}
+	return item
+}
+
+// StepN dispatches up to n events, stopping early at a breakpoint.
+func (d *Debugger) StepN(n int) Stop {
+	return d.run(nil, n, StopStep)
+}
+
+// RunUntil dispatches every event up to time t, stopping early at a breakpoint.
+func (d *Debugger) RunUntil(t float64) Stop {
+	return d.run(func(next *eventsys.EventItem) bool { return next.Timestamp > t }, -1, StopTime)
+}
+
+// Continue dispatches events until a breakpoint is hit or the simulation finishes.
+func (d *Debugger) Continue() Stop {
+	return d.run(nil, -1, StopFinished)
+}
+
+// run dispatches events until stopBefore matches the next one or limit events ran (no limit if
+// negative). Breakpoints are checked before each event except the first, so a run can always
+// move past the breakpoint it stopped at.
+func (d *Debugger) run(stopBefore func(next *eventsys.EventItem) bool, limit int, reason StopReason) Stop {
+	processed := 0
+	for !d.finished && processed != limit {
+		next := d.bus.Peek()
+		if next == nil || (stopBefore != nil && stopBefore(next)) {
+			break
+		}
+		if processed > 0 {
+			if bp := d.matchingBreakpoint(next.Event); bp != nil {
+				return Stop{Reason: StopBreakpoint, Processed: processed, Breakpoint: bp}
+			}
+		}
+		if d.Step() != nil {
+			processed++
+		}
+	}
+	if d.finished {
+		return Stop{Reason: StopFinished, Processed: processed}
+	}
+	return Stop{Reason: reason, Processed: processed}
+}
+
+func (d *Debugger) matchingBreakpoint(evt interface{}) *Breakpoint {
+	for i := range d.breakpoints {
+		if d.breakpoints[i].Matches(evt) {
+			bp := d.breakpoints[i]
+			return &bp
+		}
+	}
+	return nil
+}
+
+// finish ends the simulation the way RunSimulation does.
+func (d *Debugger) finish() {
+	if d.finished {
+		return
+	}
+	d.finished = true
+	utils.CloseAllEffectUptimes(d.sim.world, d.sim.config.MaxTime)
+}
+
+// Next returns the event that will be dispatched next, or nil when the simulation has finished.
+func (d *Debugger) Next() *eventsys.EventItem {
+	if d.finished {
+		return nil
+	}
+	return d.bus.Peek()
+}
+
+// Pending returns the queued events in dispatch order.
+func (d *Debugger) Pending() []*eventsys.EventItem {
+	return d.bus.Pending()
+}
+
+// Finished reports whether the simulation has run to its end.
+func (d *Debugger) Finished() bool {
+	return d.finished
+}
+
+// EventsProcessed returns the number of events dispatched so far.
+func (d *Debugger) EventsProcessed() int {
+	return d.processed
+}
+
+// CurrentTime returns the timestamp of the last dispatched event.
+func (d *Debugger) CurrentTime() float64 {
+	return d.sim.currentTime
+}
+
+// World returns the world being simulated, for inspecting components between events.
+func (d *Debugger) World() *ecs.World {
+	return d.sim.world
+}
+
+// Simulation returns the simulation being debugged.
+func (d *Debugger) Simulation() *Simulation {
+	return d.sim
+}
+
+func isEventType(name string) bool {
+	for _, info := range eventsys.EventTypes() {
+		if info.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -1,12 +1,13 @@
 package simulation
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"reflect"
 	"time"
 
 	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	"tft-dps-simulator/internal/core/managers"
@@ -21,11 +22,17 @@ import (
 	_ "tft-dps-simulator/internal/core/systems/traits/handlers" // For trait handlers
 )
 
+// EventObserver is called after each event is dispatched by RunSimulation.
+// Returning false stops the simulation early (e.g. a streaming client disconnected).
+type EventObserver func(item *eventsys.EventItem, currentTime float64) bool
+
 // Simulation manages the simulation loop and coordinates system execution
 type Simulation struct {
 	world    *ecs.World
+	dataSet  *data.DataSet
 	eventBus eventsys.EventBus // Interface remains the same
 	teamTraitState *traitsys.TeamTraitState 
+	damageSystem           *systems.DamageSystem
 	statCalcSystem         *systems.StatCalculationSystem
 	baseStaticItemSystem   *itemsys.BaseStaticItemSystem
 	abilityCritSystem      *itemsys.AbilityCritSystem
@@ -37,25 +44,40 @@ type Simulation struct {
 
 	config      SimulationConfig
 	currentTime float64
+	observer    EventObserver
 }
 
-// NewSimulation creates a new simulation with the given world and default config
-func NewSimulation(world *ecs.World) *Simulation {
-	return NewSimulationWithConfig(world, DefaultConfig())
+// NewSimulation creates a new simulation with the given world, game data and default config
+func NewSimulation(world *ecs.World, dataSet *data.DataSet) *Simulation {
+	return NewSimulationWithConfig(world, dataSet, DefaultConfig())
 }
 
-// NewSimulationWithConfig creates a new simulation with the given world and config
-func NewSimulationWithConfig(world *ecs.World, config SimulationConfig) *Simulation {
+// NewSimulationWithConfig creates a new simulation with the given world, game data and config
+func NewSimulationWithConfig(world *ecs.World, dataSet *data.DataSet, config SimulationConfig) *Simulation {
 	if err := config.Validate(); err != nil {
 		panic(fmt.Sprintf("Invalid simulation config: %v", err))
 	}
 
 	// Create Event Bus (which now includes the PriorityQueue)
 	eventBus := eventsys.NewSimpleBus()
+	if config.Jitter {
+		seed := config.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		eventBus = eventsys.NewSimpleBusWithJitter(seed)
+	}
+
+	sim := newSimulation(world, dataSet, config, eventBus, traitsys.NewTeamTraitState())
 
-	// Create Trait State
-	traitState := traitsys.NewTeamTraitState()
+	// apply bonus static item stats to champions AND enqueue initial events
+	sim.setupCombat()
+	return sim
+}
 
+// newSimulation creates the systems for world, registers them on eventBus and returns the simulation
+// without enqueueing anything, for NewSimulationWithConfig and Clone.
+func newSimulation(world *ecs.World, dataSet *data.DataSet, config SimulationConfig, eventBus *eventsys.SimpleBus, traitState *traitsys.TeamTraitState) *Simulation {
 	// Create Systems, passing event bus where needed
 	autoAttackSystem := systems.NewAutoAttackSystem(world, eventBus)
 	damageSystem := systems.NewDamageSystem(world, eventBus)
@@ -65,8 +87,8 @@ func NewSimulationWithConfig(world *ecs.World, config SimulationConfig) *Simulat
 	spellCastSystem := systems.NewSpellCastSystem(world, eventBus)
 	championActionSystem := systems.NewChampionActionSystem(world, eventBus)
 	debuffSystem := systems.NewDebuffSystem(world, eventBus)
-	traitManager := managers.NewTraitManager(world, traitState, eventBus)
-	traitCounterSystem := traitsys.NewTraitCounterSystem(world, traitState)
+	traitManager := managers.NewTraitManager(world, dataSet, traitState, eventBus)
+	traitCounterSystem := traitsys.NewTraitCounterSystem(world, dataSet, traitState)
 	itemManger := managers.NewItemManager(world, eventBus)
 
 	// Register Event Handlers
@@ -79,29 +101,27 @@ func NewSimulationWithConfig(world *ecs.World, config SimulationConfig) *Simulat
 	eventBus.RegisterHandler(traitManager)
 	eventBus.RegisterHandler(itemManger)
 
-	sim := &Simulation{
-		world:                  world,
-		eventBus:               eventBus,
-		teamTraitState:         traitState,
-		statCalcSystem:         statCalcSystem,
-		baseStaticItemSystem:   baseStaticItemSystem,
-		abilityCritSystem:      abilityCritSystem,
-		debuffSystem:           debuffSystem,
-		traitCounterSystem:    traitCounterSystem,
-		traitManager: traitManager,
-		itemManger: itemManger,
-		config:                 config,
-		currentTime:            0.0,
+	return &Simulation{
+		world:                world,
+		dataSet:              dataSet,
+		eventBus:             eventBus,
+		teamTraitState:       traitState,
+		damageSystem:         damageSystem,
+		statCalcSystem:       statCalcSystem,
+		baseStaticItemSystem: baseStaticItemSystem,
+		abilityCritSystem:    abilityCritSystem,
+		debuffSystem:         debuffSystem,
+		traitCounterSystem:   traitCounterSystem,
+		traitManager:         traitManager,
+		itemManger:           itemManger,
+		config:               config,
+		currentTime:          0.0,
 	}
-
-	// apply bonus static item stats to champions AND enqueue initial events
-	sim.setupCombat()
-	return sim
 }
 
 // setupCombat runs initial setup and enqueues starting events.
 func (s *Simulation) setupCombat() {
-	fmt.Println("--- Running Initial Combat Setup ---")
+	log.Println("--- Running Initial Combat Setup ---")
 	// reset all champion bonuses to ensure a clean state
 
 	// Apply static bonuses first (devlog.md L281.2)
@@ -123,9 +143,7 @@ func (s *Simulation) setupCombat() {
 	// 4. Other special handlings (e.g., Overlord - requires trait implementation) (devlog.md L283)
 
 	// Enqueue first actions for all champions at t=0 (devlog.md L284)
-	championInfoType := reflect.TypeOf(components.ChampionInfo{})
-	healthType := reflect.TypeOf(components.Health{}) // Ensure we only enqueue for living champions
-	champions := s.world.GetEntitiesWithComponents(championInfoType, healthType)
+	champions := ecs.Query2[components.ChampionInfo, components.Health](s.world) // Health: only living champions are enqueued
 	if s.config.DebugMode {
 		log.Printf("Found %d champions to enqueue initial action.", len(champions))
 	}
@@ -162,8 +180,14 @@ func (s *Simulation) SetTimeStep(seconds float64) {
 
 // RunSimulation executes the event-driven simulation loop.
 func (s *Simulation) RunSimulation() {
+	s.RunSimulationWithContext(context.Background())
+}
+
+// RunSimulationWithContext executes the event-driven simulation loop, stopping promptly
+// when ctx is cancelled or its deadline passes. Returns ctx.Err() in that case.
+func (s *Simulation) RunSimulationWithContext(ctx context.Context) error {
 	startTime := time.Now()
-	fmt.Println("\nStarting Event-Driven Simulation...")
+	log.Println("Starting Event-Driven Simulation...")
 
 	s.currentTime = 0.0 // Ensure time starts at 0
 
@@ -171,46 +195,108 @@ func (s *Simulation) RunSimulation() {
 	simpleBus, ok := s.eventBus.(*eventsys.SimpleBus)
 	if !ok {
 		log.Fatal("EventBus is not a *SimpleBus, cannot run simulation")
-		return
+		return nil
 	}
 
 	// s.itemManger.EnqueueInitialEvents()
 
 	// Main event loop
+	var runErr error
 	for simpleBus.Len() > 0 {
-		// 1. Dequeue the next event
-		eventItem := simpleBus.Dequeue()
-		if eventItem == nil { // Should not happen if Len() > 0, but safety check
+		// 0. Stop if the caller cancelled (job cancellation, timeout, client disconnect)
+		if err := ctx.Err(); err != nil {
+			log.Printf("Simulation cancelled at %.3fs: %v", s.currentTime, err)
+			runErr = err
 			break
 		}
-
-		// Check for simulation end conditions BEFORE processing
-		// Condition 1: Time exceeds MaxTime
-		if eventItem.Timestamp > s.config.MaxTime {
-			log.Printf("Simulation time (%.3fs) exceeds MaxTime (%.1fs). Stopping.", eventItem.Timestamp, s.config.MaxTime)
+		if _, ok := s.stepEvent(simpleBus); !ok {
 			break
 		}
-		// TODO: Condition 2: One team has no alive champion units (requires health/team check)
+	} // End of event loop
 
-		// 2. Set simulation time = evt.Timestamp
-		// Only advance time forward. If events are somehow scheduled in the past (shouldn't happen with jitter), log it.
-		if eventItem.Timestamp < s.currentTime {
-			log.Printf("WARN: Event timestamp %.3fs is before current time %.3fs. Processing anyway.", eventItem.Timestamp, s.currentTime)
-		}
-		s.currentTime = eventItem.Timestamp
+	// Close any buff/debuff intervals still open when combat ends.
+	utils.CloseAllEffectUptimes(s.world, s.config.MaxTime)
+
+	elapsed := time.Since(startTime)
+	log.Printf("\nSimulation Ended (Time: %.3fs, Events processed. Real time: %v)\n", s.currentTime, elapsed)
+	return runErr
+}
+
+// stepEvent dequeues and dispatches the next event. It returns false when the simulation is over:
+// the queue is empty, the next event is past MaxTime, or the observer asked to stop.
+func (s *Simulation) stepEvent(simpleBus *eventsys.SimpleBus) (*eventsys.EventItem, bool) {
+	// 1. Dequeue the next event
+	eventItem := simpleBus.Dequeue()
+	if eventItem == nil {
+		return nil, false
+	}
+
+	// Check for simulation end conditions BEFORE processing
+	// Condition 1: Time exceeds MaxTime
+	if eventItem.Timestamp > s.config.MaxTime {
+		log.Printf("Simulation time (%.3fs) exceeds MaxTime (%.1fs). Stopping.", eventItem.Timestamp, s.config.MaxTime)
+		return nil, false
+	}
+	// TODO: Condition 2: One team has no alive champion units (requires health/team check)
+
+	// 2. Set simulation time = evt.Timestamp
+	// Only advance time forward. If events are somehow scheduled in the past (shouldn't happen with jitter), log it.
+	if eventItem.Timestamp < s.currentTime {
+		log.Printf("WARN: Event timestamp %.3fs is before current time %.3fs. Processing anyway.", eventItem.Timestamp, s.currentTime)
+	}
+	s.currentTime = eventItem.Timestamp
+
+	if s.config.DebugMode {
+		log.Printf("[T=%.3fs] Dequeued: %T", s.currentTime, eventItem.Event)
+	}
+
+	// 3. Handle the event (Dispatch to registered handlers)
+	// Event handlers might enqueue subsequent events.
+	s.eventBus.Dispatch(eventItem.Event)
+
+	// 4. Notify observer (streaming); it may request an early stop
+	if s.observer != nil && !s.observer(eventItem, s.currentTime) {
+		log.Printf("Simulation stopped early by observer at %.3fs.", s.currentTime)
+		return eventItem, false
+	}
+	return eventItem, true
+}
 
-		if s.config.DebugMode {
-			log.Printf("[T=%.3fs] Dequeued: %T", s.currentTime, eventItem.Event)
+// Replay dispatches archived events into this simulation's world in their recorded order,
+// instead of running the event loop. Events handlers enqueue in response are discarded, since
+// the archive holds the ones that actually ran. Like the event loop, it skips events after
+// MaxTime and stops early when ctx is cancelled or the observer asks to.
+func (s *Simulation) Replay(ctx context.Context, events []*eventsys.EventItem) error {
+	simpleBus, ok := s.eventBus.(*eventsys.SimpleBus)
+	if !ok {
+		return fmt.Errorf("EventBus is not a *SimpleBus, cannot replay")
+	}
+	log.Printf("Replaying %d archived events...", len(events))
+
+	s.currentTime = 0.0
+	simpleBus.DiscardPending() // Initial events from setupCombat are in the archive too
+
+	var runErr error
+	for _, eventItem := range events {
+		if err := ctx.Err(); err != nil {
+			runErr = err
+			break
 		}
+		if eventItem.Timestamp > s.config.MaxTime {
+			break
+		}
+		s.currentTime = eventItem.Timestamp
 
-		// 3. Handle the event (Dispatch to registered handlers)
-		// Event handlers might enqueue subsequent events.
 		s.eventBus.Dispatch(eventItem.Event)
+		simpleBus.DiscardPending()
 
-	} // End of event loop
+		if s.observer != nil && !s.observer(eventItem, s.currentTime) {
+			break
+		}
+	}
 
-	elapsed := time.Since(startTime)
-	log.Printf("\nSimulation Ended (Time: %.3fs, Events processed. Real time: %v)\n", s.currentTime, elapsed)
+	utils.CloseAllEffectUptimes(s.world, s.config.MaxTime)
+	return runErr
 }
 
 // PrintResults displays the final simulation results
@@ -221,6 +307,16 @@ func (s *Simulation) PrintResults(entities ...entity.Entity) {
 	}
 }
 
+// SetEventObserver registers a callback invoked after every dispatched event.
+func (s *Simulation) SetEventObserver(observer EventObserver) {
+	s.observer = observer
+}
+
+// GetCurrentTime returns the timestamp of the last processed event
+func (s *Simulation) GetCurrentTime() float64 {
+	return s.currentTime
+}
+
 // GetConfig returns a copy of the current simulation configuration
 func (s *Simulation) GetConfig() SimulationConfig {
 	return s.config
@@ -18,6 +18,9 @@ func TestSimulation(t *testing.T) {
     ginkgo.RunSpecs(t, "Simulation Suite") // Run all specs in the package
 }
 
+// testData is the game data shared by every spec in the suite.
+var testData *data.DataSet
+
 // Optional: Add BeforeSuite/AfterSuite if needed for package-level setup/teardown
 var _ = ginkgo.BeforeSuite(func() {
 	// Load item data once for the entire manager suite
@@ -25,17 +28,12 @@ var _ = ginkgo.BeforeSuite(func() {
 	dataDir := "../../../assets"
 	fileName := "en_us_14.1b.json"
 	filePath := filepath.Join(dataDir, fileName)
-	tftData, err := data.LoadSetDataFromFile(filePath, "TFTSet14")
+	var err error
+	testData, err = data.LoadDataSet(filePath, "TFTSet14", "14.1b")
 	if err != nil {
 		log.Printf("Error loading set data: %v\n", err)
 		os.Exit(1)
 	}
-	data.InitializeChampions(tftData)
-
-	data.InitializeTraits(tftData)
-	data.InitializeSetActiveAugments(tftData, filePath)
-
-	data.InitializeSetActiveItems(tftData, filePath)
 
 	gomega.Expect(err).NotTo(gomega.HaveOccurred(), "Failed to load item data")
 })
@@ -75,11 +75,11 @@ var _ = Describe("Simulation", func() {
             WithMaxTime(1.0).     // Short max time for faster tests
             WithDebugMode(false) // Disable debug output by default
 
-        championFactory = factory.NewChampionFactory(world)
+        championFactory = factory.NewChampionFactory(world, testData)
         // Load real item data for the manager
         // Ensure item data is loaded correctly in your actual setup, maybe in a BeforeSuite
         // For this test, we assume item data is available via BeforeSuite in simulation_suite_test.go
-        equipmentManager = managers.NewEquipmentManager(world) // Initialize equipment manager
+        equipmentManager = managers.NewEquipmentManager(world, testData) // Initialize equipment manager
 
         // Create basic entities for interaction testing
         var err error
@@ -122,32 +122,32 @@ var _ = Describe("Simulation", func() {
 
     Describe("Initialization", func() {
         It("should create a simulation with default config", func() {
-            defaultSim := simulation.NewSimulation(world) // Assumes NewSimulation uses DefaultConfig
+            defaultSim := simulation.NewSimulation(world, testData) // Assumes NewSimulation uses DefaultConfig
             Expect(defaultSim).NotTo(BeNil())
             Expect(defaultSim.GetConfig()).To(Equal(simulation.DefaultConfig()))
         })
 
         It("should create a simulation with custom config", func() {
             customConfig := simulation.DefaultConfig().WithMaxTime(5.5)
-            customSim := simulation.NewSimulationWithConfig(world, customConfig)
+            customSim := simulation.NewSimulationWithConfig(world, testData, customConfig)
             Expect(customSim).NotTo(BeNil())
             Expect(customSim.GetConfig()).To(Equal(customConfig))
         })
 
         It("should panic with invalid config", func() {
             invalidConfig := simulation.DefaultConfig().WithTimeStep(-1) // Example invalid config
-            Expect(func() { simulation.NewSimulationWithConfig(world, invalidConfig) }).To(Panic())
+            Expect(func() { simulation.NewSimulationWithConfig(world, testData, invalidConfig) }).To(Panic())
         })
 
         It("should run initial setup (setupCombat) upon creation", func() {
             // Add an item with static stats BEFORE creating the simulation
-            deathcapData := data.GetItemByApiName(data.TFT_Item_RabadonsDeathcap)
+            deathcapData := testData.GetItemByApiName(data.TFT_Item_RabadonsDeathcap)
             Expect(deathcapData).NotTo(BeNil())
             err := equipmentManager.AddItemToChampion(attacker, data.TFT_Item_RabadonsDeathcap)
             Expect(err).NotTo(HaveOccurred())
 
             // Create the simulation - this should trigger setupCombat
-            sim = simulation.NewSimulationWithConfig(world, config)
+            sim = simulation.NewSimulationWithConfig(world, testData, config)
             Expect(sim).NotTo(BeNil())
 
             // Check if static stats were applied during setupCombat
@@ -164,7 +164,7 @@ var _ = Describe("Simulation", func() {
     Describe("Configuration", func() {
         BeforeEach(func() {
             // Create sim instance for config tests
-            sim = simulation.NewSimulationWithConfig(world, config)
+            sim = simulation.NewSimulationWithConfig(world, testData, config)
             Expect(sim).NotTo(BeNil())
         })
 
@@ -203,7 +203,7 @@ var _ = Describe("Simulation", func() {
 
         BeforeEach(func() {
             // Create sim instance for RunSimulation tests AFTER basic world setup
-            sim = simulation.NewSimulationWithConfig(world, config)
+            sim = simulation.NewSimulationWithConfig(world, testData, config)
             Expect(sim).NotTo(BeNil())
         })
 
@@ -268,7 +268,7 @@ var _ = Describe("Simulation", func() {
             debugConfig := config.WithDebugMode(true).WithMaxTime(0.6) // Removed ReportingInterval if not used
             // Create a new sim instance for this specific test
             // TODO: Need a way to inject the buffer into the simulation's logger
-            debugSim := simulation.NewSimulationWithConfig(world, debugConfig)
+            debugSim := simulation.NewSimulationWithConfig(world, testData, debugConfig)
 
             debugSim.RunSimulation()
 
@@ -295,14 +295,14 @@ var _ = Describe("Simulation", func() {
 
         //     BeforeEach(func() {
         //         // Load item data needed for these tests
-        //         quicksilverData = data.GetItemByApiName(data.TFT_Item_Quicksilver)
+        //         quicksilverData = testData.GetItemByApiName(data.TFT_Item_Quicksilver)
         //         Expect(quicksilverData).NotTo(BeNil())
         //         qsDuration = quicksilverData.Effects["SpellShieldDuration"] // e.g., 18.0
         //         qsProcInterval = quicksilverData.Effects["ProcInterval"]    // e.g., 2.0
         //         qsProcAS = quicksilverData.Effects["ProcAttackSpeed"]       // e.g., 0.03
         //         qsStaticAS = quicksilverData.Effects["AS"] / 100.0          // e.g., 0.3
 
-        //         archangelsData = data.GetItemByApiName(data.TFT_Item_ArchangelsStaff)
+        //         archangelsData = testData.GetItemByApiName(data.TFT_Item_ArchangelsStaff)
         //         Expect(archangelsData).NotTo(BeNil())
         //         aaInitialAP = archangelsData.Effects["AP"]
         //         aaInterval = archangelsData.Effects["IntervalSeconds"]    // e.g., 5.0
@@ -315,7 +315,7 @@ var _ = Describe("Simulation", func() {
         //         Expect(err).NotTo(HaveOccurred())
 
         //         // Create simulation AFTER adding the item
-        //         sim = simulation.NewSimulationWithConfig(world, config)
+        //         sim = simulation.NewSimulationWithConfig(world, testData, config)
         //         Expect(sim).NotTo(BeNil())
 
         //         attackerAttack := getAttack(world, attacker)
@@ -363,8 +363,8 @@ var _ = Describe("Simulation", func() {
 
         //         // --- Re-run from scratch for the full duration ---
         //         world = ecs.NewWorld() // Reset world
-        //         championFactory = factory.NewChampionFactory(world)
-        //         equipmentManager = managers.NewEquipmentManager(world)
+        //         championFactory = factory.NewChampionFactory(world, testData)
+        //         equipmentManager = managers.NewEquipmentManager(world, testData)
         //         attacker, _ = championFactory.CreatePlayerChampion("TFT_TrainingDummy", 1)
         //         world.AddComponent(attacker, components.NewPosition(0, 0))
         //         if _, ok := world.GetMana(attacker); !ok { world.AddComponent(attacker, components.NewMana(0, 100)) }
@@ -386,7 +386,7 @@ var _ = Describe("Simulation", func() {
 
         //         longDuration := 19.0
         //         config = config.WithMaxTime(longDuration) // Update config max time
-        //         sim = simulation.NewSimulationWithConfig(world, config) // Create ONE sim instance for the full duration
+        //         sim = simulation.NewSimulationWithConfig(world, testData, config) // Create ONE sim instance for the full duration
         //         Expect(sim).NotTo(BeNil())
 
         //         sim.RunSimulation() // Run for 19 seconds
@@ -418,7 +418,7 @@ var _ = Describe("Simulation", func() {
         //         Expect(err).NotTo(HaveOccurred())
 
         //         // Create simulation AFTER adding the item
-        //         sim = simulation.NewSimulationWithConfig(world, config)
+        //         sim = simulation.NewSimulationWithConfig(world, testData, config)
         //         Expect(sim).NotTo(BeNil())
 
         //         attackerSpell := getSpell(world, attacker)
@@ -443,8 +443,8 @@ var _ = Describe("Simulation", func() {
         //         // --- Run for ~11 seconds (expect 2 stacks at t=5.0, t=10.0) ---
         //         // Re-run from scratch for the full duration
         //         world = ecs.NewWorld() // Reset world
-        //         championFactory = factory.NewChampionFactory(world)
-        //         equipmentManager = managers.NewEquipmentManager(world)
+        //         championFactory = factory.NewChampionFactory(world, testData)
+        //         equipmentManager = managers.NewEquipmentManager(world, testData)
         //         attacker, _ = championFactory.CreatePlayerChampion("TFT_TrainingDummy", 1)
         //         world.AddComponent(attacker, components.NewPosition(0, 0))
         //         if _, ok := world.GetMana(attacker); !ok { world.AddComponent(attacker, components.NewMana(0, 100)) }
@@ -456,7 +456,7 @@ var _ = Describe("Simulation", func() {
         //         err = equipmentManager.AddItemToChampion(attacker, data.TFT_Item_ArchangelsStaff) // Add item again
         //         Expect(err).NotTo(HaveOccurred())
 
-        //         sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(11.0)) // Create sim for 11s
+        //         sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(11.0)) // Create sim for 11s
         //         Expect(sim).NotTo(BeNil())
 
         //         sim.RunSimulation() // Run for 11 seconds
@@ -490,7 +490,7 @@ var _ = Describe("Simulation", func() {
 
             BeforeEach(func() {
                 // Get Item data once for this context
-                titansData = data.GetItemByApiName(data.TFT_Item_TitansResolve)
+                titansData = testData.GetItemByApiName(data.TFT_Item_TitansResolve)
                 Expect(titansData).NotTo(BeNil())
                 titansMaxStacks = int(titansData.Effects["StackCap"])         // 25
                 titansADPerStack = titansData.Effects["StackingAD"]           // 2.0
@@ -498,7 +498,7 @@ var _ = Describe("Simulation", func() {
                 titansBonusResistsAtCap = titansData.Effects["BonusResistsAtStackCap"] // 20.0
                 titansStaticArmor = titansData.Effects["Armor"]               // 10.0
 
-                // ragebladeData = data.GetItemByApiName(data.TFT_Item_GuinsoosRageblade)
+                // ragebladeData = testData.GetItemByApiName(data.TFT_Item_GuinsoosRageblade)
                 // Expect(ragebladeData).NotTo(BeNil())
                 // ragebladeStaticAS = ragebladeData.Effects["AS"] / 100.0          // 0.1
                 // ragebladeASPerStack = ragebladeData.Effects["AttackSpeedPerStack"] / 100.0 // 0.05
@@ -518,7 +518,7 @@ var _ = Describe("Simulation", func() {
                 }
 
                 // Create simulation AFTER adding the item and setting AS
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(2)) // Run long enough for 2 attacks
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(2)) // Run long enough for 2 attacks
                 Expect(sim).NotTo(BeNil())
 
                 // Get components and initial stats *after* sim creation (setupCombat runs)
@@ -572,7 +572,7 @@ var _ = Describe("Simulation", func() {
                 attackerAttack.SetBaseAttackSpeed(0) // Stop attacker
 
                 // Create simulation AFTER setup changes
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(9.9)) // Run long enough for target's attack
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(9.9)) // Run long enough for target's attack
                 Expect(sim).NotTo(BeNil())
 
                 // Get components and initial stats
@@ -618,7 +618,7 @@ var _ = Describe("Simulation", func() {
                 }
 
                 // Create simulation AFTER setup changes
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(8))
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(8))
                 Expect(sim).NotTo(BeNil())
 
                 // Get components
@@ -657,7 +657,7 @@ var _ = Describe("Simulation", func() {
 
                 // Create simulation AFTER setup changes, running long enough for max stacks
                 timeToMaxStacks := float64(titansMaxStacks) + 1.0 // e.g., 26.0s
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(timeToMaxStacks))
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(timeToMaxStacks))
                 Expect(sim).NotTo(BeNil())
 
                 // Get components AFTER sim creation
@@ -715,7 +715,7 @@ var _ = Describe("Simulation", func() {
 
                 // --- Run simulation long enough to reach max stacks ---
                 timeToMaxStacks := float64(titansMaxStacks)+10// e.g., 24.0s
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(timeToMaxStacks))
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(timeToMaxStacks))
                 Expect(sim).NotTo(BeNil())
 
                 sim.RunSimulation() // Run first simulation part
@@ -760,7 +760,7 @@ var _ = Describe("Simulation", func() {
             //     }
 
             //     // Create simulation AFTER adding the item
-            //     sim = simulation.NewSimulationWithConfig(world, config) // Use default MaxTime initially if needed
+            //     sim = simulation.NewSimulationWithConfig(world, testData, config) // Use default MaxTime initially if needed
             //     Expect(sim).NotTo(BeNil())
 
             //     // --- Initial State Verification (after setupCombat) ---
@@ -809,7 +809,7 @@ var _ = Describe("Simulation", func() {
         // This primarily tests output formatting. Requires simulation run first.
         BeforeEach(func() {
             // Create sim instance for PrintResults tests
-            sim = simulation.NewSimulationWithConfig(world, config)
+            sim = simulation.NewSimulationWithConfig(world, testData, config)
             Expect(sim).NotTo(BeNil())
         })
 
@@ -855,8 +855,8 @@ var _ = Describe("Simulation", func() {
             // Reset world and create components/systems needed for these specific tests
             // Note: We are testing the *result* of the setup process, not RunSimulation here.
             world = ecs.NewWorld()
-            championFactory = factory.NewChampionFactory(world)
-            equipmentManager = managers.NewEquipmentManager(world)
+            championFactory = factory.NewChampionFactory(world, testData)
+            equipmentManager = managers.NewEquipmentManager(world, testData)
             // Create instances of systems involved in static stat application
             statCalculationSystem = systems.NewStatCalculationSystem(world)
             abilityCritSystem = itemsys.NewAbilityCritSystem(world)
@@ -893,7 +893,7 @@ var _ = Describe("Simulation", func() {
                 expectedAmp  float64
             )
             BeforeEach(func() {
-                deathcapData = data.GetItemByApiName(data.TFT_Item_RabadonsDeathcap)
+                deathcapData = testData.GetItemByApiName(data.TFT_Item_RabadonsDeathcap)
                 Expect(deathcapData).NotTo(BeNil())
                 expectedAP = deathcapData.Effects["AP"]           // e.g., 70
                 expectedAmp = deathcapData.Effects["BonusDamage"] // e.g., 0.08 (8%)
@@ -937,7 +937,7 @@ var _ = Describe("Simulation", func() {
                 jgCritDamageToGive float64 // e.g., 0.4 (40%)
             )
             BeforeEach(func() {
-                jgData = data.GetItemByApiName(data.TFT_Item_JeweledGauntlet)
+                jgData = testData.GetItemByApiName(data.TFT_Item_JeweledGauntlet)
                 Expect(jgData).NotTo(BeNil())
                 jgBonusAP = jgData.Effects["AP"]                     // e.g., 35
                 jgCritChance = jgData.Effects["CritChance"] / 100.0   // e.g., 0.2
@@ -1046,8 +1046,8 @@ var _ = Describe("Simulation", func() {
         BeforeEach(func() {
             // Setup world, champion, manager
             world = ecs.NewWorld()
-            championFactory = factory.NewChampionFactory(world)
-            equipmentManager = managers.NewEquipmentManager(world)
+            championFactory = factory.NewChampionFactory(world, testData)
+            equipmentManager = managers.NewEquipmentManager(world, testData)
             champion, err = championFactory.CreatePlayerChampion("TFT14_Kindred", 1)
             Expect(err).NotTo(HaveOccurred())
             championSpell = getSpell(world, champion)
@@ -1057,7 +1057,7 @@ var _ = Describe("Simulation", func() {
             }
 
             // Get Archangel's data
-            archangelsData = data.GetItemByApiName(data.TFT_Item_ArchangelsStaff)
+            archangelsData = testData.GetItemByApiName(data.TFT_Item_ArchangelsStaff)
             Expect(archangelsData).NotTo(BeNil())
             initialAP = archangelsData.Effects["AP"]
             initialMana = archangelsData.Effects["Mana"]
@@ -1070,7 +1070,7 @@ var _ = Describe("Simulation", func() {
 
             // Create simulation AFTER adding the item
             // Use a config suitable for testing intervals
-            sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(11.0)) // Default max time for this context
+            sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(11.0)) // Default max time for this context
             Expect(sim).NotTo(BeNil())
         })
 
@@ -1096,7 +1096,7 @@ var _ = Describe("Simulation", func() {
 
             // --- Run past first stack (e.g., 5.1s total) ---
             // Create a new sim instance with the same world but longer time
-            sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(interval+0.1)) // Run up to 5.1s
+            sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(interval+0.1)) // Run up to 5.1s
             sim.RunSimulation() // Re-runs from t=0 up to 5.1s
 
             Expect(effect.GetStacks()).To(Equal(1), "Stacks should be 1 after the first interval")
@@ -1107,7 +1107,7 @@ var _ = Describe("Simulation", func() {
 
             championSpell.ResetBonuses()
             // --- Run past second stack (e.g., 10.1s total) ---
-            sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(2*interval+0.1)) // Run up to 10.1s
+            sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(2*interval+0.1)) // Run up to 10.1s
             sim.RunSimulation() // Re-runs from t=0 up to 10.1s
 
             Expect(effect.GetStacks()).To(Equal(2), "Stacks should be 2 after the second interval")
@@ -1131,13 +1131,13 @@ var _ = Describe("Simulation", func() {
         BeforeEach(func() {
             // Reset world and create basic components/systems for trait tests
             world = ecs.NewWorld()
-            championFactory = factory.NewChampionFactory(world)
-            equipmentManager = managers.NewEquipmentManager(world) // Not needed unless items interact with traits
+            championFactory = factory.NewChampionFactory(world, testData)
+            equipmentManager = managers.NewEquipmentManager(world, testData) // Not needed unless items interact with traits
 
             // Create instances of systems if needed for manual checks,
             // otherwise rely on Simulation's internal creation.
             // traitState = traitsys.NewTeamTraitState()
-            // traitCounterSystem = traitsys.NewTraitCounterSystem(world, traitState)
+            // traitCounterSystem = traitsys.NewTraitCounterSystem(world, testData, traitState)
             // traitStaticBonusSystem = traitsys.NewTraitStaticBonusSystem(world, traitState)
             // statCalculationSystem = systems.NewStatCalculationSystem(world) // Needed to see final stats
         })
@@ -1169,7 +1169,7 @@ var _ = Describe("Simulation", func() {
                 blueGolemPos.SetPosition(0, 1) 
 
                 // Get trait data to find the expected bonus
-                rapidfireData = data.GetTraitByName(data.TFT14_Rapidfire)
+                rapidfireData = testData.GetTraitByName(data.TFT14_Rapidfire)
                 Expect(rapidfireData).NotTo(BeNil())
                 // Find the bonus for tier 0 (2 units)
                 var foundBonus bool
@@ -1184,7 +1184,7 @@ var _ = Describe("Simulation", func() {
                 Expect(expectedBonusAS).To(BeNumerically("~", 0.1), "Expected team bonus AS should be 10%")
 
                 // Create the simulation - this runs setupCombat which includes trait counting and static bonus application
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(0.1)) // Short time, only care about setup
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(0.1)) // Short time, only care about setup
                 Expect(sim).NotTo(BeNil())
                 // Note: setupCombat runs:
                 // 1. traitCounterSystem.UpdateCountsAndTiers()
@@ -1266,7 +1266,7 @@ var _ = Describe("Simulation", func() {
                 attackK2.ResetBonuses()
                 attackKog.ResetBonuses()
 
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(1.10)) // starting AS for Kindred and Kog'Maw is 0.77 = 0.7 * 1.1 (10% bonus from Rapidfire), 1.10s should be enough for 1 attack to land
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(1.10)) // starting AS for Kindred and Kog'Maw is 0.77 = 0.7 * 1.1 (10% bonus from Rapidfire), 1.10s should be enough for 1 attack to land
                 sim.RunSimulation() 
 
                 Expect(attackK1.GetAttackCount()).To(Equal(1), "Kindred 1 should have attacked once")
@@ -1302,7 +1302,7 @@ var _ = Describe("Simulation", func() {
                 attackK2.ResetBonuses()
                 attackKog.ResetBonuses()
 
-                sim = simulation.NewSimulationWithConfig(world, config.WithMaxTime(12.0)) 
+                sim = simulation.NewSimulationWithConfig(world, testData, config.WithMaxTime(12.0)) 
                 sim.RunSimulation() 
 
                 Expect(attackK1.GetAttackCount()).To(Equal(11), "Kindred 1 should have attacked 11 times")
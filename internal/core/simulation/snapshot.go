@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"fmt"
+
+	"tft-dps-simulator/internal/core/ecs"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+)
+
+// Clone returns an independent copy of the simulation at its current time: the world, the pending
+// events, the jitter generator and system state such as assist tracking and trait tiers. Either can
+// be continued without affecting the other, and the copy continues exactly as the original would
+// until one of them is changed. The event observer is not copied.
+func (s *Simulation) Clone() (*Simulation, error) {
+	simpleBus, ok := s.eventBus.(*eventsys.SimpleBus)
+	if !ok {
+		return nil, fmt.Errorf("EventBus is not a *SimpleBus, cannot clone")
+	}
+	return s.cloneWith(simpleBus), nil
+}
+
+func (s *Simulation) cloneWith(simpleBus *eventsys.SimpleBus) *Simulation {
+	clone := newSimulation(s.world.Clone(), s.dataSet, s.config, simpleBus.CloneQueue(), s.teamTraitState.Clone())
+	clone.damageSystem.CopyStateFrom(s.damageSystem)
+	clone.currentTime = s.currentTime
+	return clone
+}
+
+// Snapshot is a frozen copy of a simulation mid-combat. Branches started from it are independent
+// simulations, so one snapshot can seed any number of what-if runs (e.g. "from second 10, with
+// the enemy's armor shredded").
+type Snapshot struct {
+	sim *Simulation
+}
+
+// Snapshot freezes a copy of the simulation as it is now.
+func (s *Simulation) Snapshot() (*Snapshot, error) {
+	frozen, err := s.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{sim: frozen}, nil
+}
+
+// Time returns the simulation time the snapshot was taken at.
+func (snap *Snapshot) Time() float64 {
+	return snap.sim.currentTime
+}
+
+// Branch starts a new simulation from the snapshot. Its world can be changed before it is
+// continued with RunSimulation or a Debugger.
+func (snap *Snapshot) Branch() *Simulation {
+	return snap.sim.cloneWith(snap.sim.eventBus.(*eventsys.SimpleBus))
+}
+
+// World returns the branch's world, for changing it before continuing.
+func (s *Simulation) World() *ecs.World {
+	return s.world
+}
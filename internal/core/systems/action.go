@@ -25,14 +25,11 @@ func NewChampionActionSystem(world *ecs.World, bus eventsys.EventBus) *ChampionA
 	}
 }
 
-// CanHandle checks if the system can process the given event type.
-func (s *ChampionActionSystem) CanHandle(evt interface{}) bool {
-	switch evt.(type) {
-	case eventsys.ChampionActionEvent:
-		return true
-	default:
-		return false
-	}
+// Subscriptions lists the events the system processes.
+func (s *ChampionActionSystem) Subscriptions() []eventsys.Subscription {
+	return eventsys.Subscribe(eventsys.PriorityCombat,
+		eventsys.ChampionActionEvent{},
+	)
 }
 
 // HandleEvent processes events related to action decisions.
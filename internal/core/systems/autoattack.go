@@ -23,19 +23,16 @@ func NewAutoAttackSystem(world *ecs.World, bus eventsys.EventBus) *AutoAttackSys
 	}
 }
 
-// CanHandle checks if the system can process the given event type.
-func (s *AutoAttackSystem) CanHandle(evt interface{}) bool {
-	switch evt.(type) {
-	case eventsys.AttackStartupEvent,
-		eventsys.AttackFiredEvent,
-		eventsys.AttackRecoveryEndEvent,
-		eventsys.AttackCooldownStartEvent,
-		eventsys.AttackLandedEvent,
-		eventsys.AttackCooldownEndEvent:
-		return true
-	default:
-		return false
-	}
+// Subscriptions lists the events the system processes.
+func (s *AutoAttackSystem) Subscriptions() []eventsys.Subscription {
+	return eventsys.Subscribe(eventsys.PriorityCombat,
+		eventsys.AttackStartupEvent{},
+		eventsys.AttackFiredEvent{},
+		eventsys.AttackRecoveryEndEvent{},
+		eventsys.AttackCooldownStartEvent{},
+		eventsys.AttackLandedEvent{},
+		eventsys.AttackCooldownEndEvent{},
+	)
 }
 
 // HandleEvent processes events related to the auto-attack cycle.
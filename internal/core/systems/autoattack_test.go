@@ -34,7 +34,7 @@ package systems_test
 // 	BeforeEach(func() {
 // 		world = ecs.NewWorld()
 // 		eventBus = NewMockEventBus() // Initialize mock bus
-// 		championFactory = factory.NewChampionFactory(world)
+// 		championFactory = factory.NewChampionFactory(world, testData)
 // 		autoAttackSystem = systems.NewAutoAttackSystem(world, eventBus) // Pass bus to system
 
 // 		// --- Create Player (Blue Golem) ---
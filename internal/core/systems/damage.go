@@ -45,13 +45,13 @@ func (s *DamageSystem) HandleEvent(evt interface{}) {
 	}
 }
 
-func (s *DamageSystem) CanHandle(evt interface{}) bool {
-	switch evt.(type) {
-	case eventsys.AttackLandedEvent, eventsys.DamageAppliedEvent, eventsys.SpellLandedEvent:
-		return true
-	default:
-		return false
-	}
+// Subscriptions lists the events the system processes.
+func (s *DamageSystem) Subscriptions() []eventsys.Subscription {
+	return eventsys.Subscribe(eventsys.PriorityDamage,
+		eventsys.AttackLandedEvent{},
+		eventsys.DamageAppliedEvent{},
+		eventsys.SpellLandedEvent{},
+	)
 }
 
 // initDamageTracker initializes the damage tracker.
@@ -63,6 +63,28 @@ func (s *DamageSystem) initDamageTracker() {
 	}
 }
 
+// Clone returns a deep copy of the tracker.
+func (t *DamageTracker) Clone() *DamageTracker {
+	clone := &DamageTracker{damageParticipants: make(map[entity.Entity]map[entity.Entity]bool, len(t.damageParticipants))}
+	for target, sources := range t.damageParticipants {
+		copied := make(map[entity.Entity]bool, len(sources))
+		for source, participated := range sources {
+			copied[source] = participated
+		}
+		clone.damageParticipants[target] = copied
+	}
+	return clone
+}
+
+// CopyStateFrom replaces this system's assist tracking with a copy of other's,
+// for a system attached to a cloned world.
+func (s *DamageSystem) CopyStateFrom(other *DamageSystem) {
+	s.damageTracker = nil
+	if other.damageTracker != nil {
+		s.damageTracker = other.damageTracker.Clone()
+	}
+}
+
 // onAttackLanded calculates final damage from an attack and enqueues DamageAppliedEvent.
 func (s *DamageSystem) onAttackLanded(evt eventsys.AttackLandedEvent) {
 	attacker := evt.Source
@@ -130,6 +152,8 @@ func (s *DamageSystem) onAttackLanded(evt eventsys.AttackLandedEvent) {
         RawDamage:        rawDamage,
         PreMitigationDamage: preMitigationDamage,
         MitigatedDamage:  totalMitigation,
+        MitigatedByResistance: mitigatedByArmor,
+        MitigatedByDurability: mitigatedByDurability,
         FinalTotalDamage:      finalDamage,
         IsCrit:           isCrit, // Use actual crit result if implemented
         IsAbilityCrit:    false,  // Attacks are not ability crits
@@ -178,6 +202,9 @@ func (s *DamageSystem) onDamageApplied(evt eventsys.DamageAppliedEvent) {
 	//      log.Printf("  (Crit!)")
 	// }
 
+	// Update target's DefenseStats
+	s.recordDamageTaken(evt)
+
 	// Track damage participants for assist tracking
 	s.initDamageTracker()
 	if s.damageTracker.damageParticipants[target] == nil {
@@ -189,6 +216,10 @@ func (s *DamageSystem) onDamageApplied(evt eventsys.DamageAppliedEvent) {
 	if targetHealth.CurrentHP <= 0 && initialHP > 0 {
 		log.Printf("DamageSystem (onDamageApplied): %s has been defeated!\n", targetName)
 
+		if targetDefenseStats, ok := s.world.GetDefenseStats(target); ok {
+			targetDefenseStats.TimeOfDeath = evt.Timestamp
+		}
+
 		deathEvent := eventsys.DeathEvent{
 			Target:    target,
 			Timestamp: evt.Timestamp,
@@ -254,6 +285,29 @@ func (s *DamageSystem) onDamageApplied(evt eventsys.DamageAppliedEvent) {
 	// No warning if target has no mana, common for dummies/some units.
 }
 
+// recordDamageTaken updates the target's DefenseStats from a DamageAppliedEvent.
+func (s *DamageSystem) recordDamageTaken(evt eventsys.DamageAppliedEvent) {
+	defenseStats, ok := s.world.GetDefenseStats(evt.Target)
+	if !ok {
+		return
+	}
+
+	defenseStats.TotalDamageTaken += evt.FinalTotalDamage
+	defenseStats.PreMitigationDamageTaken += evt.PreMitigationDamage
+	defenseStats.MitigatedByDurability += evt.MitigatedByDurability
+
+	switch evt.DamageType {
+	case "AD":
+		defenseStats.ADDamageTaken += evt.FinalTotalDamage
+		defenseStats.MitigatedByArmor += evt.MitigatedByResistance
+	case "AP":
+		defenseStats.APDamageTaken += evt.FinalTotalDamage
+		defenseStats.MitigatedByMR += evt.MitigatedByResistance
+	case "True":
+		defenseStats.TrueDamageTaken += evt.FinalTotalDamage
+	}
+}
+
 // onSpellLanded calculates final damage from a spell and enqueues DamageAppliedEvent.
 // Triggered by SpellLandedEvent.
 func (s *DamageSystem) onSpellLanded(evt eventsys.SpellLandedEvent) { // Changed event type
@@ -365,6 +419,8 @@ func (s *DamageSystem) onSpellLanded(evt eventsys.SpellLandedEvent) { // Changed
 		RawDamage:        rawDamage,
 		PreMitigationDamage: preMitigationDamage,
 		MitigatedDamage:  totalMitigation,
+		MitigatedByResistance: mitigatedByResistance,
+		MitigatedByDurability: mitigatedByDurability,
 		FinalTotalDamage:      finalDamage,
 		IsCrit:           false, // Spells don't trigger basic attack crit flag
 		IsAbilityCrit:    isAbilityCrit, // Use actual crit result if implemented
@@ -34,7 +34,7 @@ var _ = Describe("DamageSystem", func() {
     BeforeEach(func() {
         world = ecs.NewWorld()
         mockEventBus = utils.NewMockEventBus()
-        championFactory = factory.NewChampionFactory(world) // Factory now adds Crit component
+        championFactory = factory.NewChampionFactory(world, testData) // Factory now adds Crit component
         damageSystem = systems.NewDamageSystem(world, mockEventBus)
 		mockEventBus.RegisterHandler(damageSystem)
 
@@ -397,6 +397,37 @@ var _ = Describe("DamageSystem", func() {
             })
         })
 
+        Context("when tracking the target's DefenseStats", func() {
+            var targetDefenseStats *components.DefenseStats
+
+            BeforeEach(func() {
+                targetDefenseStats, ok = world.GetDefenseStats(target)
+                Expect(ok).To(BeTrue())
+                damageEvent.PreMitigationDamage = 80.0
+                damageEvent.MitigatedDamage = 30.0
+                damageEvent.MitigatedByResistance = 20.0
+                damageEvent.MitigatedByDurability = 10.0
+            })
+
+            It("should record damage taken by type and mitigation by source", func() {
+                damageSystem.HandleEvent(damageEvent)
+                Expect(targetDefenseStats.TotalDamageTaken).To(Equal(50.0))
+                Expect(targetDefenseStats.ADDamageTaken).To(Equal(50.0))
+                Expect(targetDefenseStats.PreMitigationDamageTaken).To(Equal(80.0))
+                Expect(targetDefenseStats.MitigatedByArmor).To(Equal(20.0))
+                Expect(targetDefenseStats.MitigatedByMR).To(Equal(0.0))
+                Expect(targetDefenseStats.MitigatedByDurability).To(Equal(10.0))
+            })
+
+            It("should record the time of death when damage is lethal", func() {
+                targetHealth.SetCurrentHP(damageEvent.FinalTotalDamage - 1)
+                damageSystem.HandleEvent(damageEvent)
+                Expect(targetDefenseStats.TimeOfDeath).To(Equal(eventTime))
+                targetDefenseStats.FinalizeTimeAlive(30.0)
+                Expect(targetDefenseStats.TimeAlive).To(Equal(eventTime))
+            })
+        })
+
         // TODO: Add test for target gaining mana when hit
     })
 })
\ No newline at end of file
@@ -7,6 +7,7 @@ import (
 	"tft-dps-simulator/internal/core/components/debuffs"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
+	"tft-dps-simulator/internal/core/utils"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
 )
 
@@ -22,13 +23,14 @@ func NewDebuffSystem(world *ecs.World, eventBus eventsys.EventBus) *DebuffSystem
     }
 }
 
-func (s *DebuffSystem) CanHandle(evt interface{}) bool {
-    switch evt.(type) {
-    case eventsys.ApplyDebuffEvent, eventsys.RemoveDebuffEvent, eventsys.BurnTickEvent, eventsys.DebuffExpiredEvent:
-        return true
-    default:
-        return false
-    }
+// Subscriptions lists the events the system processes.
+func (s *DebuffSystem) Subscriptions() []eventsys.Subscription {
+    return eventsys.Subscribe(eventsys.PriorityCombat,
+        eventsys.ApplyDebuffEvent{},
+        eventsys.RemoveDebuffEvent{},
+        eventsys.BurnTickEvent{},
+        eventsys.DebuffExpiredEvent{},
+    )
 }
 
 func (s *DebuffSystem) HandleEvent(evt interface{}) {
@@ -72,6 +74,7 @@ func (s *DebuffSystem) applyShred(target entity.Entity, mrReduction, duration, e
     } else {
         shredEffect := debuffs.NewShredEffect(mrReduction, duration, endTime, source, sourceType, sourceId)
         s.world.AddComponent(target, shredEffect)
+        utils.RecordEffectStart(s.world, target, string(debuffs.Shred), timestamp)
         log.Printf("DebuffSystem: Applied Shred to entity %d (%.1f MR reduction, %.1fs duration) from %s", 
             target, mrReduction, duration, sourceId)
     }
@@ -105,6 +108,7 @@ func (s *DebuffSystem) applySunder(target entity.Entity, armorReduction, duratio
     } else {
         sunderEffect := debuffs.NewSunderEffect(armorReduction, duration, endTime, source, sourceType, sourceId)
         s.world.AddComponent(target, sunderEffect)
+        utils.RecordEffectStart(s.world, target, string(debuffs.Sunder), timestamp)
         log.Printf("DebuffSystem: Applied Sunder to entity %d (%.1f armor reduction, %.1fs duration) from %s", 
             target, armorReduction, duration, sourceId)
     }
@@ -137,6 +141,7 @@ func (s *DebuffSystem) applyWound(target entity.Entity, healingReduction, durati
     } else {
         woundEffect := debuffs.NewWoundEffect(healingReduction, duration, endTime, source, sourceType, sourceId)
         s.world.AddComponent(target, woundEffect)
+        utils.RecordEffectStart(s.world, target, string(debuffs.Wound), timestamp)
         log.Printf("DebuffSystem: Applied Wound to entity %d (%.1f%% healing reduction, %.1fs duration) from %s", 
             target, healingReduction*100, duration, sourceId)
     }
@@ -168,6 +173,7 @@ func (s *DebuffSystem) applyBurn(target entity.Entity, damagePercent, duration,
     } else {
         burnEffect := debuffs.NewBurnEffect(damagePercent, duration, endTime, source, sourceType, sourceId)
         s.world.AddComponent(target, burnEffect)
+        utils.RecordEffectStart(s.world, target, string(debuffs.Burn), timestamp)
         isNewBurn = true
         log.Printf("DebuffSystem: Applied Burn to entity %d (%.1f%% max HP per second, %.1fs duration) from %s", 
             target, damagePercent*100, duration, sourceId)
@@ -269,6 +275,7 @@ func (s *DebuffSystem) handleDebuffExpiration(evt eventsys.DebuffExpiredEvent) {
     case debuffs.Shred:
         if shred, exists := s.world.GetShredEffect(evt.Target); exists && shred.GetSourceId() == evt.SourceId {
             s.world.RemoveComponent(evt.Target, reflect.TypeOf(debuffs.ShredEffect{}))
+            utils.RecordEffectEnd(s.world, evt.Target, string(debuffs.Shred), evt.Timestamp)
 			// Remove the MR reduction from health component
 			healthComp, ok := s.world.GetHealth(evt.Target)
 			if ok {
@@ -282,6 +289,7 @@ func (s *DebuffSystem) handleDebuffExpiration(evt eventsys.DebuffExpiredEvent) {
     case debuffs.Sunder:
         if sunder, exists := s.world.GetSunderEffect(evt.Target); exists && sunder.GetSourceId() == evt.SourceId {
             s.world.RemoveComponent(evt.Target, reflect.TypeOf(debuffs.SunderEffect{}))
+            utils.RecordEffectEnd(s.world, evt.Target, string(debuffs.Sunder), evt.Timestamp)
 			// Remove the armor reduction from health component
 			healthComp, ok := s.world.GetHealth(evt.Target)
 			if ok {
@@ -295,6 +303,7 @@ func (s *DebuffSystem) handleDebuffExpiration(evt eventsys.DebuffExpiredEvent) {
     case debuffs.Wound:
         if wound, exists := s.world.GetWoundEffect(evt.Target); exists && wound.GetSourceId() == evt.SourceId {
             s.world.RemoveComponent(evt.Target, reflect.TypeOf(debuffs.WoundEffect{}))
+            utils.RecordEffectEnd(s.world, evt.Target, string(debuffs.Wound), evt.Timestamp)
 			// Remove the healing reduction from health component
 			healthComp, ok := s.world.GetHealth(evt.Target)
 			if ok {
@@ -307,6 +316,7 @@ func (s *DebuffSystem) handleDebuffExpiration(evt eventsys.DebuffExpiredEvent) {
     case debuffs.Burn:
         if burn, exists := s.world.GetBurnEffect(evt.Target); exists && burn.GetSourceId() == evt.SourceId {
             s.world.RemoveComponent(evt.Target, reflect.TypeOf(debuffs.BurnEffect{}))
+            utils.RecordEffectEnd(s.world, evt.Target, string(debuffs.Burn), evt.Timestamp)
             log.Printf("DebuffSystem: Removed expired Burn from entity %d", evt.Target)
         }
     }
@@ -320,6 +330,7 @@ func (s *DebuffSystem) removeDebuff(evt eventsys.RemoveDebuffEvent) {
         if shred, exists := s.world.GetShredEffect(target); exists {
             if evt.SourceId == "" || shred.GetSourceId() == evt.SourceId {
                 s.world.RemoveComponent(target, reflect.TypeOf(debuffs.ShredEffect{}))
+                utils.RecordEffectEnd(s.world, target, string(debuffs.Shred), evt.Timestamp)
 				// Remove the MR reduction from health component
 				healthComp, ok := s.world.GetHealth(target)
 				if ok {
@@ -335,6 +346,7 @@ func (s *DebuffSystem) removeDebuff(evt eventsys.RemoveDebuffEvent) {
         if sunder, exists := s.world.GetSunderEffect(target); exists {
             if evt.SourceId == "" || sunder.GetSourceId() == evt.SourceId {
                 s.world.RemoveComponent(target, reflect.TypeOf(debuffs.SunderEffect{}))
+                utils.RecordEffectEnd(s.world, target, string(debuffs.Sunder), evt.Timestamp)
 				// Remove the armor reduction from health component
 				healthComp, ok := s.world.GetHealth(target)
 				if ok {
@@ -350,6 +362,7 @@ func (s *DebuffSystem) removeDebuff(evt eventsys.RemoveDebuffEvent) {
         if wound, exists := s.world.GetWoundEffect(target); exists {
             if evt.SourceId == "" || wound.GetSourceId() == evt.SourceId {
                 s.world.RemoveComponent(target, reflect.TypeOf(debuffs.WoundEffect{}))
+                utils.RecordEffectEnd(s.world, target, string(debuffs.Wound), evt.Timestamp)
 				// Remove the healing reduction from health component
 				healthComp, ok := s.world.GetHealth(target)
 				if ok {
@@ -364,6 +377,7 @@ func (s *DebuffSystem) removeDebuff(evt eventsys.RemoveDebuffEvent) {
         if burn, exists := s.world.GetBurnEffect(target); exists {
             if evt.SourceId == "" || burn.GetSourceId() == evt.SourceId {
                 s.world.RemoveComponent(target, reflect.TypeOf(debuffs.BurnEffect{}))
+                utils.RecordEffectEnd(s.world, target, string(debuffs.Burn), evt.Timestamp)
                 log.Printf("DebuffSystem: Forcibly removed Burn from entity %d (ticks will stop automatically)", target)
             }
 		}
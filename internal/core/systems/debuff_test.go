@@ -0,0 +1,69 @@
+package systems_test
+
+import (
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/components/debuffs"
+	"tft-dps-simulator/internal/core/ecs"
+	"tft-dps-simulator/internal/core/entity"
+	"tft-dps-simulator/internal/core/systems"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+	"tft-dps-simulator/internal/core/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DebuffSystem", func() {
+	var (
+		world        *ecs.World
+		mockEventBus *utils.MockEventBus
+		debuffSystem *systems.DebuffSystem
+		source       entity.Entity
+		target       entity.Entity
+	)
+
+	BeforeEach(func() {
+		world = ecs.NewWorld()
+		mockEventBus = utils.NewMockEventBus()
+		debuffSystem = systems.NewDebuffSystem(world, mockEventBus)
+		mockEventBus.RegisterHandler(debuffSystem)
+
+		source = world.NewEntity()
+		target = world.NewEntity()
+		world.AddComponent(target, components.NewHealth(1000, 50, 50))
+	})
+
+	Describe("Uptime tracking", func() {
+		It("should record an interval from application to expiration", func() {
+			mockEventBus.Enqueue(eventsys.ApplyDebuffEvent{
+				Target: target, Source: source, DebuffType: debuffs.Sunder,
+				Value: 20, Duration: 3.0, Timestamp: 1.0, SourceType: "Item", SourceId: "TestItem",
+			}, 1.0)
+			mockEventBus.ProcessUntilEmpty()
+
+			uptime, ok := world.GetEffectUptime(target)
+			Expect(ok).To(BeTrue())
+			intervals := uptime.GetIntervals(string(debuffs.Sunder))
+			Expect(intervals).To(HaveLen(1))
+			Expect(intervals[0].Start).To(BeNumerically("~", 1.0, 1e-9))
+			Expect(intervals[0].End).To(BeNumerically("~", 4.0, 1e-9))
+			Expect(uptime.GetUptimePercent(string(debuffs.Sunder), 10.0)).To(BeNumerically("~", 0.3, 1e-9))
+		})
+
+		It("should close intervals still open at the end of combat", func() {
+			mockEventBus.Enqueue(eventsys.ApplyDebuffEvent{
+				Target: target, Source: source, DebuffType: debuffs.Shred,
+				Value: 10, Duration: 999.0, Timestamp: 2.0, SourceType: "Item", SourceId: "TestItem",
+			}, 2.0)
+			mockEventBus.ProcessUntilTime(5.0)
+
+			uptime, ok := world.GetEffectUptime(target)
+			Expect(ok).To(BeTrue())
+			Expect(uptime.IsEffectActive(string(debuffs.Shred))).To(BeTrue())
+
+			utils.CloseAllEffectUptimes(world, 30.0)
+			Expect(uptime.IsEffectActive(string(debuffs.Shred))).To(BeFalse())
+			Expect(uptime.GetActiveDuration(string(debuffs.Shred))).To(BeNumerically("~", 28.0, 1e-9))
+		})
+	})
+})
@@ -14,7 +14,7 @@ type EventBus interface {
 
 // SimpleBus implements a basic synchronous event bus.
 type SimpleBus struct {
-	handlers     []EventHandler
+	router       *Router        // Handlers by event type
 	queue        *PriorityQueue // Use the priority queue
 	archiveQueue []*EventItem   // Archive of processed events
 }
@@ -22,15 +22,33 @@ type SimpleBus struct {
 // NewSimpleBus creates a new SimpleBus.
 func NewSimpleBus() *SimpleBus {
 	return &SimpleBus{
-		handlers:     make([]EventHandler, 0),
+		router:       NewRouter(),
 		queue:        NewPriorityQueue(),    // Initialize the priority queue
 		archiveQueue: make([]*EventItem, 0), // Initialize the archive queue
 	}
 }
 
-// RegisterHandler adds a new event handler.
+// NewSimpleBusWithJitter creates a SimpleBus that jitters event timestamps, so simultaneous events
+// resolve in a random order that is reproducible for a given seed.
+func NewSimpleBusWithJitter(seed int64) *SimpleBus {
+	bus := NewSimpleBus()
+	bus.queue = NewPriorityQueueWithJitter(seed)
+	return bus
+}
+
+// CloneQueue returns a bus without handlers that holds a copy of this bus's pending events and
+// archive. Simulation.Clone registers the handlers of the copied world on it.
+func (b *SimpleBus) CloneQueue() *SimpleBus {
+	return &SimpleBus{
+		router:       NewRouter(),
+		queue:        b.queue.Clone(),
+		archiveQueue: append(make([]*EventItem, 0, len(b.archiveQueue)), b.archiveQueue...),
+	}
+}
+
+// RegisterHandler subscribes a handler to the events in its Subscriptions.
 func (b *SimpleBus) RegisterHandler(handler EventHandler) {
-	b.handlers = append(b.handlers, handler)
+	b.router.Register(handler)
 }
 
 // Enqueue adds an event to the priority queue.
@@ -55,21 +73,37 @@ func (b *SimpleBus) Len() int {
 	return b.queue.Len()
 }
 
+// Peek returns the next event item without dequeuing it, or nil if the queue is empty.
+func (b *SimpleBus) Peek() *EventItem {
+	return b.queue.Peek()
+}
+
+// Pending returns the queued event items in the order they will be dispatched.
+func (b *SimpleBus) Pending() []*EventItem {
+	return b.queue.Items()
+}
+
+// DiscardPending drops every queued event. Replays use it to throw away the follow-up events
+// handlers enqueue, since the archive being replayed already contains them.
+func (b *SimpleBus) DiscardPending() {
+	for b.queue.Len() > 0 {
+		b.queue.Dequeue()
+	}
+}
+
 // GetArchive returns the archive of processed events.
 // This can be used to generate time sequence diagrams.
 func (b *SimpleBus) GetArchive() []*EventItem {
 	return b.archiveQueue
 }
 
-// Dispatch sends a single event to all registered handlers that can handle it.
+// Dispatch sends a single event to the handlers subscribed to its type, highest priority first.
 func (b *SimpleBus) Dispatch(evt interface{}) {
 	if evt == nil {
 		return
 	}
-	for _, handler := range b.handlers {
-		if handler.CanHandle(evt) {
-			log.Printf("DEBUG: Dispatching event (%T): %+v to handler: %T", evt, evt, handler)
-			handler.HandleEvent(evt)
-		}
+	for _, handler := range b.router.Handlers(evt) {
+		log.Printf("DEBUG: Dispatching event (%T): %+v to handler: %T", evt, evt, handler)
+		handler.HandleEvent(evt)
 	}
 }
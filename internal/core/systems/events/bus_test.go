@@ -0,0 +1,71 @@
+package eventsys_test
+
+import (
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingHandler records the events it handles in a log shared with other handlers.
+type recordingHandler struct {
+	name string
+	subs []eventsys.Subscription
+	log  *[]string
+}
+
+func (h *recordingHandler) HandleEvent(evt interface{}) {
+	*h.log = append(*h.log, h.name)
+}
+
+func (h *recordingHandler) Subscriptions() []eventsys.Subscription {
+	return h.subs
+}
+
+var _ = Describe("SimpleBus.Dispatch", func() {
+	var (
+		bus *eventsys.SimpleBus
+		log []string
+	)
+
+	BeforeEach(func() {
+		bus = eventsys.NewSimpleBus()
+		log = nil
+	})
+
+	register := func(name string, subs ...eventsys.Subscription) {
+		bus.RegisterHandler(&recordingHandler{name: name, subs: subs, log: &log})
+	}
+
+	It("only calls the handlers subscribed to the event type", func() {
+		register("attacks", eventsys.Subscribe(eventsys.PriorityDefault, eventsys.AttackLandedEvent{})...)
+		register("spells", eventsys.Subscribe(eventsys.PriorityDefault, eventsys.SpellLandedEvent{})...)
+
+		bus.Dispatch(eventsys.SpellLandedEvent{})
+		bus.Dispatch(eventsys.DeathEvent{})
+		Expect(log).To(Equal([]string{"spells"}))
+	})
+
+	It("calls higher priorities first and equal priorities in registration order", func() {
+		register("items", eventsys.Subscribe(eventsys.PriorityDefault, eventsys.AttackLandedEvent{})...)
+		register("traits", eventsys.Subscribe(eventsys.PriorityTraits, eventsys.AttackLandedEvent{})...)
+		register("damage", eventsys.Subscribe(eventsys.PriorityDamage, eventsys.AttackLandedEvent{})...)
+		register("more items", eventsys.Subscribe(eventsys.PriorityDefault, eventsys.AttackLandedEvent{})...)
+
+		bus.Dispatch(eventsys.AttackLandedEvent{})
+		Expect(log).To(Equal([]string{"damage", "traits", "items", "more items"}))
+	})
+
+	It("uses the priority of each subscription", func() {
+		register("a",
+			eventsys.Subscription{Event: eventsys.AttackLandedEvent{}, Priority: eventsys.PriorityDefault},
+			eventsys.Subscription{Event: eventsys.SpellLandedEvent{}, Priority: eventsys.PriorityStats},
+			eventsys.Subscription{Event: eventsys.SpellLandedEvent{}, Priority: eventsys.PriorityDefault},
+		)
+		register("b", eventsys.Subscribe(eventsys.PriorityCombat, eventsys.AttackLandedEvent{}, eventsys.SpellLandedEvent{})...)
+
+		bus.Dispatch(eventsys.AttackLandedEvent{})
+		bus.Dispatch(eventsys.SpellLandedEvent{})
+		Expect(log).To(Equal([]string{"b", "a", "a", "b"}))
+	})
+})
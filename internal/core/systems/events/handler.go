@@ -1,8 +1,9 @@
 package eventsys
 
-// EventHandler defines the interface for types that can handle specific events.
+// EventHandler defines the interface for types that handle specific events.
 type EventHandler interface {
     HandleEvent(evt interface{})
-    // CanHandle returns true if the handler can process the given event type.
-    CanHandle(evt interface{}) bool
+    // Subscriptions lists the event types the handler processes, each with the priority it
+    // handles them at. The bus only dispatches those events to the handler.
+    Subscriptions() []Subscription
 }
@@ -0,0 +1,68 @@
+package eventsys
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Phase orders events that happen at the same timestamp: lower phases are dispatched first.
+// Within a phase, events are dispatched in the order they were enqueued.
+type Phase int
+
+const (
+	PhaseStats   Phase = iota // Stat recalculation, so everything else at that time sees current stats
+	PhaseEffects              // Buffs, debuffs and item ticks changing state
+	PhaseDamage               // Attacks and spells landing, damage and burn ticks
+	PhaseDeath                // Deaths, kills and assists caused by that damage
+	PhaseAction               // Champions deciding and running their attack and spell cycles
+)
+
+var (
+	eventPhasesMu sync.RWMutex
+	eventPhases   = map[reflect.Type]Phase{}
+)
+
+// SetEventPhase sets the phase of evt's type. Event types without one are in PhaseEffects.
+func SetEventPhase(evt interface{}, phase Phase) {
+	eventPhasesMu.Lock()
+	defer eventPhasesMu.Unlock()
+	eventPhases[reflect.TypeOf(evt)] = phase
+}
+
+// EventPhase returns the phase of evt's type.
+func EventPhase(evt interface{}) Phase {
+	eventPhasesMu.RLock()
+	defer eventPhasesMu.RUnlock()
+	if phase, ok := eventPhases[reflect.TypeOf(evt)]; ok {
+		return phase
+	}
+	return PhaseEffects
+}
+
+func init() {
+	SetEventPhase(RecalculateStatsEvent{}, PhaseStats)
+
+	for _, evt := range []interface{}{
+		ArchangelsTickEvent{}, GuinsoosRagebladeTickEvent{}, QuicksilverProcEvent{}, QuicksilverEndEvent{},
+		SpiritVisageHealTickEvent{}, BlueBuffDamageAmpActivateEvent{}, BlueBuffDamageAmpDeactivateEvent{},
+		NashorsToothDeactivateEvent{}, EvenshroudResistActivateEvent{}, EvenshroudResistDeactivateEvent{},
+		ApplyDebuffEvent{}, DebuffExpiredEvent{}, RemoveDebuffEvent{},
+	} {
+		SetEventPhase(evt, PhaseEffects)
+	}
+
+	for _, evt := range []interface{}{AttackLandedEvent{}, SpellLandedEvent{}, DamageAppliedEvent{}, BurnTickEvent{}} {
+		SetEventPhase(evt, PhaseDamage)
+	}
+
+	for _, evt := range []interface{}{DeathEvent{}, KillEvent{}, AssistEvent{}} {
+		SetEventPhase(evt, PhaseDeath)
+	}
+
+	for _, evt := range []interface{}{
+		ChampionActionEvent{}, AttackStartupEvent{}, AttackFiredEvent{}, AttackRecoveryEndEvent{},
+		AttackCooldownStartEvent{}, AttackCooldownEndEvent{}, SpellCastCycleStartEvent{}, SpellRecoveryEndEvent{},
+	} {
+		SetEventPhase(evt, PhaseAction)
+	}
+}
@@ -3,25 +3,39 @@ package eventsys
 import (
     "container/heap"
     "math/rand"
-    "time"
+    "sort"
 )
 
-// EventItem holds an event and its priority (EnqueueTimestamp) for the queue.
+// EventItem holds an event and its ordering key for the queue: EnqueueTimestamp, then Phase,
+// then Sequence.
 type EventItem struct {
     Event            interface{} // The actual event data
     Timestamp        float64     // The logical time the event occurs
-    EnqueueTimestamp float64     // Timestamp + jitter, used for priority sorting
+    EnqueueTimestamp float64     // Timestamp, plus jitter when the queue uses it
+    Phase            Phase       // Order among events at the same timestamp
+    Sequence         uint64      // Enqueue order, the last tie-breaker
     index            int         // Index of the item in the heap
 }
 
+// before reports whether a is dispatched before b.
+func (a *EventItem) before(b *EventItem) bool {
+    if a.EnqueueTimestamp != b.EnqueueTimestamp {
+        return a.EnqueueTimestamp < b.EnqueueTimestamp
+    }
+    if a.Phase != b.Phase {
+        return a.Phase < b.Phase
+    }
+    return a.Sequence < b.Sequence
+}
+
 // EventQueue implements heap.Interface and holds EventItems.
 type EventQueue []*EventItem
 
 func (eq EventQueue) Len() int { return len(eq) }
 
 func (eq EventQueue) Less(i, j int) bool {
-    // Min-heap based on EnqueueTimestamp
-    return eq[i].EnqueueTimestamp < eq[j].EnqueueTimestamp
+    // Min-heap based on the ordering key
+    return eq[i].before(eq[j])
 }
 
 func (eq EventQueue) Swap(i, j int) {
@@ -38,7 +52,7 @@ func (eq *EventQueue) Push(x interface{}) {
     *eq = append(*eq, item)
 }
 
-// Pop removes and returns the item with the highest priority (lowest EnqueueTimestamp).
+// Pop removes and returns the item with the highest priority (lowest ordering key).
 func (eq *EventQueue) Pop() interface{} {
     old := *eq
     n := len(old)
@@ -56,32 +70,89 @@ type EventEnqueuer interface {
 }
 
 // PriorityQueue wraps the EventQueue and provides Enqueue method.
+//
+// Events at the same timestamp are dispatched by phase, then in the order they were enqueued,
+// so a run is the same every time. A queue with jitter instead adds U(-10^-5, +10^-5) to each
+// timestamp, which shuffles simultaneous events for Monte Carlo runs.
 type PriorityQueue struct {
-    queue *EventQueue
-    rng   *rand.Rand
+    queue    *EventQueue
+    sequence uint64
+    rng      *rand.Rand      // Jitter generator, nil without jitter
+    source   *countingSource // rng's source, nil without jitter
+}
+
+// countingSource counts the values drawn from a seeded source, so that a copy of the
+// queue can resume the jitter sequence at the same position.
+type countingSource struct {
+    rand.Source64
+    seed  int64
+    draws uint64
+}
+
+func newCountingSource(seed int64, draws uint64) *countingSource {
+    source := &countingSource{Source64: rand.NewSource(seed).(rand.Source64), seed: seed}
+    for source.draws < draws {
+        source.Int63()
+    }
+    return source
+}
+
+func (s *countingSource) Int63() int64 {
+    s.draws++
+    return s.Source64.Int63()
+}
+
+func (s *countingSource) Uint64() uint64 {
+    s.draws++
+    return s.Source64.Uint64()
 }
 
-// NewPriorityQueue creates a new event priority queue.
+// NewPriorityQueue creates a new event priority queue without jitter.
 func NewPriorityQueue() *PriorityQueue {
     eq := make(EventQueue, 0)
     heap.Init(&eq)
-    // Seed random number generator for jitter
-    source := rand.NewSource(time.Now().UnixNano())
-    return &PriorityQueue{
-        queue: &eq,
-        rng:   rand.New(source),
+    return &PriorityQueue{queue: &eq}
+}
+
+// NewPriorityQueueWithJitter creates a priority queue that jitters timestamps, reproducibly for
+// a given seed.
+func NewPriorityQueueWithJitter(seed int64) *PriorityQueue {
+    pq := NewPriorityQueue()
+    pq.source = newCountingSource(seed, 0)
+    pq.rng = rand.New(pq.source)
+    return pq
+}
+
+// Clone returns an independent copy of the queue: the same pending events in the same order,
+// and a jitter generator that continues where this one is.
+func (pq *PriorityQueue) Clone() *PriorityQueue {
+    eq := make(EventQueue, len(*pq.queue))
+    for i, item := range *pq.queue {
+        copied := *item
+        eq[i] = &copied
     }
+    clone := &PriorityQueue{queue: &eq, sequence: pq.sequence}
+    if pq.source != nil {
+        clone.source = newCountingSource(pq.source.seed, pq.source.draws)
+        clone.rng = rand.New(clone.source)
+    }
+    return clone
 }
 
-// Enqueue adds an event to the priority queue with jitter.
+// Enqueue adds an event to the priority queue.
 func (pq *PriorityQueue) Enqueue(evt interface{}, timestamp float64) {
-    // Add small random jitter based on design doc U(-10^-5, +10^-5)
-    jitter := (pq.rng.Float64()*2 - 1) * 1e-5
-    enqueueTimestamp := timestamp + jitter
+    enqueueTimestamp := timestamp
+    if pq.rng != nil {
+        // Add small random jitter based on design doc U(-10^-5, +10^-5)
+        enqueueTimestamp += (pq.rng.Float64()*2 - 1) * 1e-5
+    }
+    pq.sequence++
     item := &EventItem{
         Event:            evt,
         Timestamp:        timestamp,
         EnqueueTimestamp: enqueueTimestamp,
+        Phase:            EventPhase(evt),
+        Sequence:         pq.sequence,
     }
     heap.Push(pq.queue, item)
 }
@@ -98,4 +169,21 @@ func (pq *PriorityQueue) Dequeue() *EventItem {
 // Len returns the number of items in the queue.
 func (pq *PriorityQueue) Len() int {
     return pq.queue.Len()
-}
\ No newline at end of file
+}
+// Peek returns the next event item without removing it, or nil if the queue is empty.
+func (pq *PriorityQueue) Peek() *EventItem {
+    if pq.queue.Len() == 0 {
+        return nil
+    }
+    return (*pq.queue)[0]
+}
+
+// Items returns the queued event items in dispatch order. The queue itself is left untouched.
+func (pq *PriorityQueue) Items() []*EventItem {
+    items := make([]*EventItem, pq.queue.Len())
+    copy(items, *pq.queue)
+    sort.Slice(items, func(i, j int) bool {
+        return items[i].before(items[j])
+    })
+    return items
+}
@@ -0,0 +1,92 @@
+package eventsys_test
+
+import (
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PriorityQueue.Clone", func() {
+	It("keeps the pending events and continues the same jitter sequence", func() {
+		queue := eventsys.NewPriorityQueueWithJitter(7)
+		for i := 0; i < 5; i++ {
+			queue.Enqueue(eventsys.ChampionActionEvent{Timestamp: float64(i % 2)}, float64(i%2))
+		}
+		queue.Dequeue()
+
+		clone := queue.Clone()
+		queue.Enqueue(eventsys.DeathEvent{Target: 1, Timestamp: 0.5}, 0.5)
+		clone.Enqueue(eventsys.DeathEvent{Target: 1, Timestamp: 0.5}, 0.5)
+		Expect(clone.Len()).To(Equal(queue.Len()))
+
+		for queue.Len() > 0 {
+			want, got := queue.Dequeue(), clone.Dequeue()
+			Expect(got).NotTo(BeIdenticalTo(want))
+			Expect(got.Event).To(Equal(want.Event))
+			Expect(got.EnqueueTimestamp).To(Equal(want.EnqueueTimestamp))
+		}
+	})
+})
+
+var _ = Describe("PriorityQueue ordering", func() {
+	dequeueAll := func(queue *eventsys.PriorityQueue) []interface{} {
+		var events []interface{}
+		for queue.Len() > 0 {
+			events = append(events, queue.Dequeue().Event)
+		}
+		return events
+	}
+
+	It("orders events at the same timestamp by phase, then enqueue order", func() {
+		queue := eventsys.NewPriorityQueue()
+		queue.Enqueue(eventsys.ChampionActionEvent{Entity: 1, Timestamp: 1}, 1)
+		queue.Enqueue(eventsys.AttackLandedEvent{Source: 1, Timestamp: 1}, 1)
+		queue.Enqueue(eventsys.DeathEvent{Target: 2, Timestamp: 1}, 1)
+		queue.Enqueue(eventsys.AttackLandedEvent{Source: 2, Timestamp: 1}, 1)
+		queue.Enqueue(eventsys.RecalculateStatsEvent{Entity: 1, Timestamp: 1}, 1)
+		queue.Enqueue(eventsys.ChampionActionEvent{Entity: 1, Timestamp: 0.5}, 0.5)
+
+		Expect(dequeueAll(queue)).To(Equal([]interface{}{
+			eventsys.ChampionActionEvent{Entity: 1, Timestamp: 0.5},
+			eventsys.RecalculateStatsEvent{Entity: 1, Timestamp: 1},
+			eventsys.AttackLandedEvent{Source: 1, Timestamp: 1},
+			eventsys.AttackLandedEvent{Source: 2, Timestamp: 1},
+			eventsys.DeathEvent{Target: 2, Timestamp: 1},
+			eventsys.ChampionActionEvent{Entity: 1, Timestamp: 1},
+		}))
+	})
+
+	It("lists pending events in dispatch order", func() {
+		queue := eventsys.NewPriorityQueue()
+		queue.Enqueue(eventsys.AttackLandedEvent{Source: 1, Timestamp: 1}, 1)
+		queue.Enqueue(eventsys.RecalculateStatsEvent{Entity: 1, Timestamp: 1}, 1)
+
+		items := queue.Items()
+		Expect(items[0].Event).To(BeAssignableToTypeOf(eventsys.RecalculateStatsEvent{}))
+		Expect(items[0].Phase).To(Equal(eventsys.PhaseStats))
+		Expect(items[1].Phase).To(Equal(eventsys.PhaseDamage))
+		Expect(queue.Peek()).To(BeIdenticalTo(items[0]))
+	})
+
+	It("jitters timestamps reproducibly in jitter mode", func() {
+		enqueue := func(queue *eventsys.PriorityQueue) []float64 {
+			for i := 0; i < 20; i++ {
+				queue.Enqueue(eventsys.ChampionActionEvent{Entity: 1, Timestamp: 1}, 1)
+			}
+			var times []float64
+			for _, item := range queue.Items() {
+				times = append(times, item.EnqueueTimestamp)
+			}
+			return times
+		}
+
+		first := enqueue(eventsys.NewPriorityQueueWithJitter(3))
+		Expect(first).To(Equal(enqueue(eventsys.NewPriorityQueueWithJitter(3))))
+		Expect(first).NotTo(Equal(enqueue(eventsys.NewPriorityQueueWithJitter(4))))
+		for _, t := range first {
+			Expect(t).To(BeNumerically("~", 1, 1e-5))
+		}
+		Expect(enqueue(eventsys.NewPriorityQueue())).To(HaveEach(1.0))
+	})
+})
@@ -0,0 +1,195 @@
+package eventsys
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"tft-dps-simulator/internal/core/entity"
+)
+
+// EventTypeInfo describes a registered event type. The wire name ("DamageAppliedEvent.v1") is
+// part of the public API: it stays the same if the Go type is renamed or moved, and the version
+// is bumped whenever the payload changes incompatibly.
+type EventTypeInfo struct {
+	Name        string `json:"name"`    // Unversioned name, e.g. "DamageAppliedEvent"
+	Version     int    `json:"version"` // Payload version
+	Description string `json:"description"`
+
+	goType reflect.Type
+}
+
+// TypeName returns the versioned wire name, e.g. "DamageAppliedEvent.v1".
+func (info EventTypeInfo) TypeName() string {
+	return fmt.Sprintf("%s.v%d", info.Name, info.Version)
+}
+
+var (
+	eventTypesByName = map[string]*EventTypeInfo{}       // Versioned and unversioned name -> info
+	eventTypesByGo   = map[reflect.Type]*EventTypeInfo{} // Go type -> info
+)
+
+func init() {
+	RegisterEventType(ChampionActionEvent{}, "ChampionActionEvent", 1, "A champion checks whether it can attack or cast.")
+	RegisterEventType(AttackStartupEvent{}, "AttackStartupEvent", 1, "An auto attack wind-up begins.")
+	RegisterEventType(AttackFiredEvent{}, "AttackFiredEvent", 1, "An auto attack is fired at its target.")
+	RegisterEventType(AttackLandedEvent{}, "AttackLandedEvent", 1, "An auto attack lands on its target.")
+	RegisterEventType(AttackRecoveryEndEvent{}, "AttackRecoveryEndEvent", 1, "An auto attack recovery period ends.")
+	RegisterEventType(AttackCooldownStartEvent{}, "AttackCooldownStartEvent", 1, "An attack cooldown begins.")
+	RegisterEventType(AttackCooldownEndEvent{}, "AttackCooldownEndEvent", 1, "An attack cooldown ends.")
+	RegisterEventType(DamageAppliedEvent{}, "DamageAppliedEvent", 1, "Damage was calculated and applied to a target.")
+	RegisterEventType(DeathEvent{}, "DeathEvent", 1, "An entity's HP reached zero.")
+	RegisterEventType(KillEvent{}, "KillEvent", 1, "An entity dealt the killing blow to another.")
+	RegisterEventType(AssistEvent{}, "AssistEvent", 1, "An entity assisted in a kill.")
+	RegisterEventType(SpellCastCycleStartEvent{}, "SpellCastCycleStartEvent", 1, "A spell cast begins.")
+	RegisterEventType(SpellLandedEvent{}, "SpellLandedEvent", 1, "A spell's effect is applied.")
+	RegisterEventType(SpellRecoveryEndEvent{}, "SpellRecoveryEndEvent", 1, "A spell recovery period ends.")
+	RegisterEventType(ArchangelsTickEvent{}, "ArchangelsTickEvent", 1, "Archangel's Staff grants its periodic AP.")
+	RegisterEventType(GuinsoosRagebladeTickEvent{}, "GuinsoosRagebladeTickEvent", 1, "Guinsoo's Rageblade grants its periodic attack speed.")
+	RegisterEventType(QuicksilverProcEvent{}, "QuicksilverProcEvent", 1, "Quicksilver grants its periodic attack speed.")
+	RegisterEventType(QuicksilverEndEvent{}, "QuicksilverEndEvent", 1, "Quicksilver's active duration ends.")
+	RegisterEventType(SpiritVisageHealTickEvent{}, "SpiritVisageHealTickEvent", 1, "Spirit Visage heals its holder.")
+	RegisterEventType(BlueBuffDamageAmpActivateEvent{}, "BlueBuffDamageAmpActivateEvent", 1, "Blue Buff's damage amp starts.")
+	RegisterEventType(BlueBuffDamageAmpDeactivateEvent{}, "BlueBuffDamageAmpDeactivateEvent", 1, "Blue Buff's damage amp ends.")
+	RegisterEventType(NashorsToothDeactivateEvent{}, "NashorsToothDeactivateEvent", 1, "Nashor's Tooth attack speed bonus ends.")
+	RegisterEventType(EvenshroudResistActivateEvent{}, "EvenshroudResistActivateEvent", 1, "Evenshroud's resistance bonus starts.")
+	RegisterEventType(EvenshroudResistDeactivateEvent{}, "EvenshroudResistDeactivateEvent", 1, "Evenshroud's resistance bonus ends.")
+	RegisterEventType(RecalculateStatsEvent{}, "RecalculateStatsEvent", 1, "An entity's stats need recalculating.")
+	RegisterEventType(ApplyDebuffEvent{}, "ApplyDebuffEvent", 1, "A debuff is applied to a target.")
+	RegisterEventType(DebuffExpiredEvent{}, "DebuffExpiredEvent", 1, "A debuff expired.")
+	RegisterEventType(BurnTickEvent{}, "BurnTickEvent", 1, "Burn damage ticks on a target.")
+	RegisterEventType(RemoveDebuffEvent{}, "RemoveDebuffEvent", 1, "A debuff is removed before it expires.")
+}
+
+// RegisterEventType adds an event type to the registry under name and version.
+// It panics on duplicates, since registration happens at init time.
+func RegisterEventType(evt interface{}, name string, version int, description string) {
+	goType := reflect.TypeOf(evt)
+	info := &EventTypeInfo{Name: name, Version: version, Description: description, goType: goType}
+	if _, exists := eventTypesByName[info.TypeName()]; exists {
+		panic(fmt.Sprintf("event type %s registered twice", info.TypeName()))
+	}
+	if _, exists := eventTypesByGo[goType]; exists {
+		panic(fmt.Sprintf("Go type %s registered twice", goType))
+	}
+	eventTypesByName[info.TypeName()] = info
+	eventTypesByName[name] = info
+	eventTypesByGo[goType] = info
+}
+
+// EventTypes lists every registered event type, sorted by name.
+func EventTypes() []EventTypeInfo {
+	infos := make([]EventTypeInfo, 0, len(eventTypesByGo))
+	for _, info := range eventTypesByGo {
+		infos = append(infos, *info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// EventTypeName returns the versioned wire name of an event, or "" for unregistered types.
+func EventTypeName(evt interface{}) string {
+	t := reflect.TypeOf(evt)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if info, ok := eventTypesByGo[t]; ok {
+		return info.TypeName()
+	}
+	return ""
+}
+
+var entityType = reflect.TypeOf(entity.Entity(0))
+
+// EventEntities returns the entities an event refers to (Entity, Source, Target, Killer, ...),
+// in field order. Zero entity IDs are skipped.
+func EventEntities(evt interface{}) []entity.Entity {
+	v := reflect.ValueOf(evt)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var entities []entity.Entity
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Type == entityType {
+			if e := entity.Entity(v.Field(i).Uint()); e != 0 {
+				entities = append(entities, e)
+			}
+		}
+	}
+	return entities
+}
+
+// EncodeEvent returns the event's wire name and its fields as JSON.
+// It fails for unregistered event types.
+func EncodeEvent(evt interface{}) (string, json.RawMessage, error) {
+	name := EventTypeName(evt)
+	if name == "" {
+		return "", nil, fmt.Errorf("unregistered event type %T", evt)
+	}
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return "", nil, fmt.Errorf("error encoding %s: %w", name, err)
+	}
+	return name, payload, nil
+}
+
+// DecodeEvent rebuilds an event value (not a pointer, as handlers expect) from a wire name and
+// payload. Unversioned names decode as the registered version.
+func DecodeEvent(name string, payload json.RawMessage) (interface{}, error) {
+	info, ok := eventTypesByName[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", name)
+	}
+	if strings.Contains(name, ".v") && name != info.TypeName() {
+		return nil, fmt.Errorf("unsupported version of event type %q", name)
+	}
+	evt := reflect.New(info.goType)
+	if err := json.Unmarshal(payload, evt.Interface()); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", name, err)
+	}
+	return evt.Elem().Interface(), nil
+}
+
+// eventItemJSON is the wire form of an EventItem: the event fields under "Event", tagged
+// with the event's versioned type name.
+type eventItemJSON struct {
+	Type             string          `json:"type"`
+	Timestamp        float64         `json:"Timestamp"`
+	EnqueueTimestamp float64         `json:"EnqueueTimestamp"`
+	Event            json.RawMessage `json:"Event"`
+}
+
+// MarshalJSON encodes the item with a "type" discriminator for its event.
+func (item EventItem) MarshalJSON() ([]byte, error) {
+	name, payload, err := EncodeEvent(item.Event)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(eventItemJSON{
+		Type:             name,
+		Timestamp:        item.Timestamp,
+		EnqueueTimestamp: item.EnqueueTimestamp,
+		Event:            payload,
+	})
+}
+
+// UnmarshalJSON decodes an item written by MarshalJSON back into a typed event.
+func (item *EventItem) UnmarshalJSON(data []byte) error {
+	var wire eventItemJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	evt, err := DecodeEvent(wire.Type, wire.Event)
+	if err != nil {
+		return err
+	}
+	item.Event = evt
+	item.Timestamp = wire.Timestamp
+	item.EnqueueTimestamp = wire.EnqueueTimestamp
+	return nil
+}
@@ -0,0 +1,74 @@
+package eventsys_test
+
+import (
+	"encoding/json"
+
+	"tft-dps-simulator/internal/core/components/debuffs"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event type registry", func() {
+	It("names events by type and version", func() {
+		Expect(eventsys.EventTypeName(eventsys.DamageAppliedEvent{})).To(Equal("DamageAppliedEvent.v1"))
+		Expect(eventsys.EventTypeName(&eventsys.AttackLandedEvent{})).To(Equal("AttackLandedEvent.v1"))
+		Expect(eventsys.EventTypeName(struct{}{})).To(BeEmpty())
+	})
+
+	It("round trips an event item through JSON with a type discriminator", func() {
+		item := eventsys.EventItem{
+			Event: eventsys.ApplyDebuffEvent{
+				Target: 2, Source: 1, DebuffType: debuffs.Shred, Value: 0.3, Duration: 5, Timestamp: 1.5, SourceId: "TFT_Item_LastWhisper",
+			},
+			Timestamp:        1.5,
+			EnqueueTimestamp: 1.5000001,
+		}
+
+		encoded, err := json.Marshal(item)
+		Expect(err).NotTo(HaveOccurred())
+		var wire map[string]interface{}
+		Expect(json.Unmarshal(encoded, &wire)).To(Succeed())
+		Expect(wire["type"]).To(Equal("ApplyDebuffEvent.v1"))
+		Expect(wire["Event"]).To(HaveKeyWithValue("SourceId", "TFT_Item_LastWhisper"))
+
+		var decoded eventsys.EventItem
+		Expect(json.Unmarshal(encoded, &decoded)).To(Succeed())
+		Expect(decoded.Event).To(Equal(item.Event))
+		Expect(decoded.Timestamp).To(Equal(item.Timestamp))
+		Expect(decoded.EnqueueTimestamp).To(Equal(item.EnqueueTimestamp))
+	})
+
+	It("decodes unversioned names and rejects unknown types and versions", func() {
+		evt, err := eventsys.DecodeEvent("DeathEvent", json.RawMessage(`{"Target": 3, "Timestamp": 4.5}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(evt).To(Equal(eventsys.DeathEvent{Target: 3, Timestamp: 4.5}))
+
+		_, err = eventsys.DecodeEvent("DeathEvent.v9", json.RawMessage(`{}`))
+		Expect(err).To(HaveOccurred())
+		_, err = eventsys.DecodeEvent("NotAnEvent", json.RawMessage(`{}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("refuses to encode unregistered events", func() {
+		_, err := json.Marshal(eventsys.EventItem{Event: "not an event"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("describes every registered type in the schema", func() {
+		schema := eventsys.Schema()
+		defs := schema["$defs"].(map[string]interface{})
+		Expect(defs).To(HaveLen(len(eventsys.EventTypes())))
+		Expect(schema["oneOf"]).To(HaveLen(len(eventsys.EventTypes())))
+
+		damage := defs["DamageAppliedEvent.v1"].(map[string]interface{})
+		properties := damage["properties"].(map[string]interface{})
+		Expect(properties["Source"]).To(Equal(map[string]interface{}{"type": "integer"}))
+		Expect(properties["FinalTotalDamage"]).To(Equal(map[string]interface{}{"type": "number"}))
+		Expect(properties["IsCrit"]).To(Equal(map[string]interface{}{"type": "boolean"}))
+
+		debuff := defs["ApplyDebuffEvent.v1"].(map[string]interface{})
+		Expect(debuff["properties"]).To(HaveKeyWithValue("DebuffType", map[string]interface{}{"type": "string"}))
+	})
+})
@@ -0,0 +1,77 @@
+package eventsys
+
+import "reflect"
+
+// EventSchemaID identifies the schema returned by Schema.
+const EventSchemaID = "https://tft-dps-simulator/schemas/event-item.json"
+
+// Schema returns a JSON Schema (draft 2020-12) for serialized EventItems. Every registered event
+// type is a branch of "oneOf", selected by the "type" discriminator; its payload schema is listed
+// under "$defs" by versioned name.
+func Schema() map[string]interface{} {
+	defs := map[string]interface{}{}
+	branches := []interface{}{}
+	for _, info := range EventTypes() {
+		name := info.TypeName()
+		defs[name] = structSchema(info.goType, info.Description)
+		branches = append(branches, map[string]interface{}{
+			"type":     "object",
+			"required": []string{"type", "Timestamp", "Event"},
+			"properties": map[string]interface{}{
+				"type":             map[string]interface{}{"const": name},
+				"Timestamp":        map[string]interface{}{"type": "number"},
+				"EnqueueTimestamp": map[string]interface{}{"type": "number"},
+				"Event":            map[string]interface{}{"$ref": "#/$defs/" + name},
+			},
+		})
+	}
+	return map[string]interface{}{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"$id":         EventSchemaID,
+		"title":       "EventItem",
+		"description": "A simulation event with its versioned type name and payload.",
+		"oneOf":       branches,
+		"$defs":       defs,
+	}
+}
+
+// structSchema describes the exported fields of an event struct as they are encoded by encoding/json.
+func structSchema(t reflect.Type, description string) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		properties[field.Name] = map[string]interface{}{"type": jsonType(field.Type)}
+		required = append(required, field.Name)
+	}
+	return map[string]interface{}{
+		"type":                 "object",
+		"description":          description,
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// jsonType maps a Go field type to its JSON Schema type. Entity IDs are integers and
+// named string types such as debuffs.DebuffType are strings.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
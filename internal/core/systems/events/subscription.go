@@ -0,0 +1,102 @@
+package eventsys
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+)
+
+// Priority orders the handlers of one event: higher priorities handle it first, and handlers with
+// equal priority handle it in the order they were registered.
+type Priority int
+
+const (
+	PriorityStats   Priority = 400 // Stat recalculation, before anything reads the stats
+	PriorityDamage  Priority = 300 // Damage resolution and tracking
+	PriorityCombat  Priority = 200 // Action, auto-attack, spell and debuff cycles
+	PriorityTraits  Priority = 100 // Trait effects reacting to combat
+	PriorityDefault Priority = 0   // Item effects and anything else
+)
+
+// Subscription subscribes a handler to one event type.
+type Subscription struct {
+	Event    interface{} // A value of the event type, e.g. AttackLandedEvent{}
+	Priority Priority
+}
+
+// Subscribe returns subscriptions to each of events at the same priority.
+func Subscribe(priority Priority, events ...interface{}) []Subscription {
+	subs := make([]Subscription, len(events))
+	for i, evt := range events {
+		subs[i] = Subscription{Event: evt, Priority: priority}
+	}
+	return subs
+}
+
+// route is one handler's subscription to an event type.
+type route struct {
+	handler  EventHandler
+	priority Priority
+	order    int // Registration order, to break priority ties
+}
+
+// Router finds the handlers subscribed to an event with a map lookup on its type.
+type Router struct {
+	routes     map[reflect.Type][]route
+	handlers   map[reflect.Type][]EventHandler // routes in dispatch order
+	registered int
+}
+
+// NewRouter creates a router without handlers.
+func NewRouter() *Router {
+	return &Router{
+		routes:   make(map[reflect.Type][]route),
+		handlers: make(map[reflect.Type][]EventHandler),
+	}
+}
+
+// Register subscribes handler to the events in its Subscriptions. It panics on a nil event type,
+// and ignores a second subscription of the same handler to the same event type.
+func (r *Router) Register(handler EventHandler) {
+	order := r.registered
+	r.registered++
+	for _, sub := range handler.Subscriptions() {
+		if sub.Event == nil {
+			panic(fmt.Sprintf("eventsys: %T subscribes to a nil event", handler))
+		}
+		t := reflect.TypeOf(sub.Event)
+		if r.subscribed(t, handler) {
+			log.Printf("Warning: %T subscribes to %v more than once, ignoring the duplicate\n", handler, t)
+			continue
+		}
+		routes := append(r.routes[t], route{handler: handler, priority: sub.Priority, order: order})
+		sort.SliceStable(routes, func(i, j int) bool {
+			if routes[i].priority != routes[j].priority {
+				return routes[i].priority > routes[j].priority
+			}
+			return routes[i].order < routes[j].order
+		})
+		r.routes[t] = routes
+
+		handlers := make([]EventHandler, len(routes))
+		for i, rt := range routes {
+			handlers[i] = rt.handler
+		}
+		r.handlers[t] = handlers
+	}
+}
+
+func (r *Router) subscribed(t reflect.Type, handler EventHandler) bool {
+	for _, rt := range r.routes[t] {
+		if rt.handler == handler {
+			return true
+		}
+	}
+	return false
+}
+
+// Handlers returns the handlers subscribed to evt's type, in dispatch order.
+func (r *Router) Handlers(evt interface{}) []EventHandler {
+	return r.handlers[reflect.TypeOf(evt)]
+}
@@ -69,6 +69,8 @@ type DamageAppliedEvent struct {
     RawDamage        float64
     PreMitigationDamage float64
     MitigatedDamage  float64
+    MitigatedByResistance float64 // Portion of MitigatedDamage prevented by armor (AD) or magic resist (AP)
+    MitigatedByDurability float64 // Portion of MitigatedDamage prevented by durability
     FinalTotalDamage float64
     IsCrit           bool
     IsAbilityCrit    bool
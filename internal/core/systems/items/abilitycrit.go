@@ -2,7 +2,6 @@ package itemsys
 
 import (
 	"log"
-	"reflect"
 
 	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/ecs"
@@ -23,15 +22,13 @@ func NewAbilityCritSystem(world *ecs.World) *AbilityCritSystem {
 // Update checks equipped items and applies the CanAbilitiesCrit marker.
 func (s *AbilityCritSystem) Update() {
 	// We need entities that have equipment to check.
-	equipmentType := reflect.TypeOf(components.Equipment{})
-	entities := s.world.GetEntitiesWithComponents(equipmentType)
-	itemMarkerType := reflect.TypeOf(components.CanAbilityCritFromItems{})
+	entities := ecs.Query[components.Equipment](s.world)
 
 	for _, entity := range entities {
 		equipment, _ := s.world.GetEquipment(entity)
 		hasInifityEdge := equipment.HasItem("TFT_Item_InfinityEdge")
 		hasJeweledGauntlet := equipment.HasItem("TFT_Item_JeweledGauntlet")
-		_, hasAbilityCritFromItemsMarker := s.world.GetComponent(entity, itemMarkerType)
+		hasAbilityCritFromItemsMarker := ecs.Has[components.CanAbilityCritFromItems](s.world, entity)
 
 		if hasInifityEdge || hasJeweledGauntlet && !hasAbilityCritFromItemsMarker {
 			log.Printf("(AbilityCritSystem) Entity %d: Adding CanAbilityCritFromItems component .", entity)
@@ -43,7 +40,7 @@ func (s *AbilityCritSystem) Update() {
 			// Use Case: When IE/JG is removed from a champion, we need to remove the marker.
 			// Remove the marker component if no relevant items are equipped
 			log.Printf("(AbilityCritSystem) Entity %d: Removing CanAbilityCritFromItems component.", entity)
-			s.world.RemoveComponent(entity, itemMarkerType)
+			ecs.Remove[components.CanAbilityCritFromItems](s.world, entity)
 		}
 
 	}
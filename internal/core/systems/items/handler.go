@@ -1,6 +1,9 @@
 package itemsys
 
 import (
+	"reflect"
+
+	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
@@ -9,15 +12,36 @@ import (
 // ItemHandler defines the interface for item-specific logic.
 // Each dynamic item should have an implementation of this interface.
 type ItemHandler interface {
-    // OnEquip is called by the EquipmentManager when the item is added to an entity.
-    // It should set up item-specific components (e.g., ArchangelsEffect) and
-    // enqueue initial events if necessary (e.g., first ArchangelsTickEvent).
+    // NewEffect builds the item's effect component (e.g. *items.ArchangelsStaffEffect) from its
+    // data, usually item.Effects. The EquipmentManager adds it when the item is added to an entity
+    // that does not have one yet, and removes it with the last copy of the item. Items without
+    // a component return nil.
+    NewEffect(item *data.Item) interface{}
+
+    // EffectType is the component type NewEffect builds (e.g. items.ArchangelsStaffEffect), or nil
+    // for items without a component. The EquipmentManager removes the component by this type.
+    EffectType() reflect.Type
+
+    // OnEquip is called by the ItemManager when combat starts, after the effect component was added.
+    // It should reset the component and enqueue initial events if necessary (e.g., first ArchangelsTickEvent).
     OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus)
 
+    // OnUnequip is called by the EquipmentManager when the item is removed from an entity, before
+    // the effect component is removed. It should undo any bonuses the item added to the entity's
+    // stats. eventBus is nil outside of combat. Handlers with nothing to undo embed NoUnequip.
+    OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus)
+
     // ProcessEvent is called by the main ItemSystem when a relevant game event occurs.
     // The handler should determine if and how to react to the event.
     // This method will handle both general game events (like AttackLandedEvent for Guinsoo's)
     // and item-specific tick/proc events (like ArchangelsTickEvent for Archangel's Staff).
     // The handler is responsible for type-asserting the event and acting accordingly.
     ProcessEvent(event interface{}, entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus)
-}
\ No newline at end of file
+}
+
+// NoUnequip provides an empty OnUnequip for handlers whose item only keeps state in its effect
+// component, which the EquipmentManager removes.
+type NoUnequip struct{}
+
+// OnUnequip implements ItemHandler.
+func (NoUnequip) OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {}
@@ -2,6 +2,9 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
+
+	"tft-dps-simulator/internal/core/components/items"
 
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
@@ -10,12 +13,24 @@ import (
 	itemsys "tft-dps-simulator/internal/core/systems/items"
 )
 
-type ArchangelsStaffHandler struct{}
+type ArchangelsStaffHandler struct {
+	itemsys.NoUnequip
+}
 
 func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_ArchangelsStaff, &ArchangelsStaffHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *ArchangelsStaffHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewArchangelsEffect(item.Effects["IntervalSeconds"], item.Effects["APPerInterval"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *ArchangelsStaffHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.ArchangelsStaffEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 func (h *ArchangelsStaffHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	log.Printf("ArchangelsStaffHandler: OnEquip for entity %d", entity)
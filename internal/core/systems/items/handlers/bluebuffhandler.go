@@ -2,21 +2,37 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
 
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
 	itemsys "tft-dps-simulator/internal/core/systems/items"
+	"tft-dps-simulator/internal/core/utils"
 )
 
 // BlueBuffHandler handler implements the Blue Buff item effects
-type BlueBuffHandler struct{}
+type BlueBuffHandler struct {
+	itemsys.NoUnequip
+}
 
 func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_BlueBuff, &BlueBuffHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *BlueBuffHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewBlueBuff()
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *BlueBuffHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.BlueBuffEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler
 func (h *BlueBuffHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	log.Printf("BlueBuff: OnEquip for entity %d", entity)
@@ -148,6 +164,7 @@ func (h *BlueBuffHandler) handleDamageAmpActivation(evt eventsys.BlueBuffDamageA
 	// If not already active, add the damage amplification to stats
 	if !wasActive {
 		attack.AddBonusDamageAmp(blueBuff.DamageAmp)
+		utils.RecordEffectStart(world, entity, components.UptimeBlueBuffDamageAmp, evt.Timestamp)
 		log.Printf("BlueBuff (handleDamageAmpActivation): Entity %d activated damage amplification at %.3fs (%.1f%% for %.1fs)",
 			entity, evt.Timestamp, blueBuff.DamageAmp*100, blueBuff.TakedownTimer)
 	} else {
@@ -196,6 +213,7 @@ func (h *BlueBuffHandler) handleDamageAmpDeactivation(evt eventsys.BlueBuffDamag
 		// Remove the damage amplification from stats
 		attack.AddBonusDamageAmp(-blueBuff.DamageAmp)
 		blueBuff.DeactivateAmplification()
+		utils.RecordEffectEnd(world, entity, components.UptimeBlueBuffDamageAmp, evt.Timestamp)
 
 		log.Printf("BlueBuff (handleDamageAmpDeactivation): Entity %d deactivated damage amplification at %.3fs",
 			entity, evt.Timestamp)
@@ -7,11 +7,13 @@ import (
 
 	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/components/debuffs"
+	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
 	itemsys "tft-dps-simulator/internal/core/systems/items"
+	"tft-dps-simulator/internal/core/utils"
 )
 
 type EvenshroudHandler struct{}
@@ -21,6 +23,16 @@ func init() {
     itemsys.RegisterItemHandler(data.TFT_Item_Evenshroud, &EvenshroudHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *EvenshroudHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewEvenshroudEffect(item.Effects["ARReductionAmount"], item.Effects["HexRange"], item.Effects["BonusResists"], item.Effects["BonusResistDuration"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *EvenshroudHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.EvenshroudEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 func (h *EvenshroudHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
     log.Printf("EvenshroudHandler: OnEquip for entity %d", entity)
@@ -40,8 +52,8 @@ func (h *EvenshroudHandler) OnEquip(entity entity.Entity, world *ecs.World, even
 func (h *EvenshroudHandler) OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
     log.Printf("EvenshroudHandler: OnUnequip for entity %d", entity)
     
-    // Deactivate resistance bonus if active
-    if effect, ok := world.GetEvenshroudEffect(entity); ok && effect.IsResistBonusActive() {
+    // Deactivate resistance bonus if active (it only is during combat, when there is a bus)
+    if effect, ok := world.GetEvenshroudEffect(entity); ok && eventBus != nil && effect.IsResistBonusActive() {
         deactivateEvent := eventsys.EvenshroudResistDeactivateEvent{
             Entity:    entity,
             Timestamp: 0.0, // Immediate
@@ -87,6 +99,7 @@ func (h *EvenshroudHandler) handleResistActivate(evt eventsys.EvenshroudResistAc
 
     // Activate the resistance bonus
     effect.ActivateResistBonus()
+    utils.RecordEffectStart(world, entity, components.UptimeEvenshroudResists, evt.Timestamp)
 
     // Add temporary armor and magic resist bonuses
     bonusResists := effect.GetBonusResists()
@@ -123,6 +136,7 @@ func (h *EvenshroudHandler) handleResistDeactivate(evt eventsys.EvenshroudResist
 
     // Deactivate the resistance bonus
     effect.DeactivateResistBonus()
+    utils.RecordEffectEnd(world, entity, components.UptimeEvenshroudResists, evt.Timestamp)
 
     // Remove temporary armor and magic resist bonuses
     bonusResists := effect.GetBonusResists()
@@ -194,7 +208,7 @@ func (h *EvenshroudHandler) getEnemiesInRange(sourceEntity entity.Entity, source
     var enemies []entity.Entity
 
     // Get all entities with position and team components
-    positionEntities := world.GetEntitiesWithComponents(reflect.TypeOf(components.Position{}), reflect.TypeOf(components.Team{}))
+    positionEntities := ecs.Query2[components.Position, components.Team](world)
 
     for _, targetEntity := range positionEntities {
         if targetEntity == sourceEntity {
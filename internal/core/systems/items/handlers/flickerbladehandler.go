@@ -2,6 +2,9 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
+
+	"tft-dps-simulator/internal/core/components/items"
 
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
@@ -10,12 +13,24 @@ import (
 	itemsys "tft-dps-simulator/internal/core/systems/items"
 )
 
-type FlickerbladeHandler struct{}
+type FlickerbladeHandler struct {
+	itemsys.NoUnequip
+}
 
 func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_Artifact_NavoriFlickerblades, &FlickerbladeHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *FlickerbladeHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewFlickerbladeEffect(item.Effects["ASPerStack"], item.Effects["ADPerBonus"], item.Effects["APPerBonus"], item.Effects["StacksPerBonus"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *FlickerbladeHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.FlickerbladeEffect{})
+}
+
 func (h *FlickerbladeHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	log.Printf("FlickerbladeHandler: OnEquip for entity %d", entity)
 	if effect, exists := world.GetFlickerbladeEffect(entity); exists {
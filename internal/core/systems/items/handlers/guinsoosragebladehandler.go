@@ -2,6 +2,9 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
+
+	"tft-dps-simulator/internal/core/components/items"
 
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
@@ -16,6 +19,22 @@ func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_GuinsoosRageblade, &GuinsoosRagebladeHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *GuinsoosRagebladeHandler) NewEffect(item *data.Item) interface{} {
+	asPerStack := item.Effects["AttackSpeedPerStack"]
+	// TODO: fix the effect field name when the data is updated
+	intervalSeconds, ok := item.Effects["IntervalSeconds"]
+	if !ok {
+		intervalSeconds = 1.0 // Default to 1.0 if not found
+	}
+	return items.NewGuinsoosRagebladeEffect(intervalSeconds, asPerStack/100)
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *GuinsoosRagebladeHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.GuinsoosRagebladeEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 func (h *GuinsoosRagebladeHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	log.Printf("GuinsoosRagebladeHandler: OnEquip for entity %d", entity)
@@ -37,6 +56,25 @@ func (h *GuinsoosRagebladeHandler) OnEquip(entity entity.Entity, world *ecs.Worl
 	}
 }
 
+// OnUnequip implements itemsys.ItemHandler.
+func (h *GuinsoosRagebladeHandler) OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
+	log.Printf("GuinsoosRagebladeHandler: OnUnequip for entity %d", entity)
+
+	effect, exists := world.GetGuinsoosRagebladeEffect(entity)
+	if !exists {
+		return
+	}
+	// Subtract the attack speed the stacks granted from the core Attack component
+	totalBonusAS := effect.GetCurrentBonusAS()
+	if attackComp, ok := world.GetAttack(entity); ok && totalBonusAS > 0 {
+		attackComp.AddBonusPercentAttackSpeed(-totalBonusAS)
+		log.Printf("  Reversed Guinsoo's AS: -%.2f%%. Total Bonus AS now: %.2f%%", totalBonusAS*100, attackComp.GetBonusPercentAttackSpeed()*100)
+	}
+	// The component outlives this copy when another Rageblade is equipped, so its stacks
+	// must not be reversed a second time.
+	effect.ResetEffects()
+}
+
 func (h *GuinsoosRagebladeHandler) ProcessEvent(event interface{}, entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	ragebladeTickEvent, ok := event.(eventsys.GuinsoosRagebladeTickEvent)
 	if !ok || ragebladeTickEvent.Entity != entity {
@@ -2,6 +2,8 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
+	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
@@ -10,12 +12,24 @@ import (
 	itemsys "tft-dps-simulator/internal/core/systems/items"
 )
 
-type KrankensFuryHandler struct{}
+type KrankensFuryHandler struct {
+	itemsys.NoUnequip
+}
 
 func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_KrakensFury, &KrankensFuryHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *KrankensFuryHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewKrakensFuryEffect(item.Effects["ADOnAttack"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *KrankensFuryHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.KrakensFuryEffect{})
+}
+
 func (h *KrankensFuryHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	log.Printf("KrankensFuryHandler: OnEquip for entity %d. No initial events to enqueue.", entity)
 
@@ -2,12 +2,16 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
 
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
 	itemsys "tft-dps-simulator/internal/core/systems/items"
+	"tft-dps-simulator/internal/core/utils"
 )
 
 type NashorsToothHandler struct{}
@@ -16,6 +20,17 @@ func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_NashorsTooth, &NashorsToothHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *NashorsToothHandler) NewEffect(item *data.Item) interface{} {
+	// AttackSpeedToGive is a percentage; the effect stores a decimal
+	return items.NewNashorsToothEffect(item.Effects["AttackSpeedToGive"]/100.0, item.Effects["ASDuration"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *NashorsToothHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.NashorsToothEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 func (h *NashorsToothHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
     log.Printf("NashorsToothHandler: OnEquip for entity %d", entity)
@@ -29,24 +44,30 @@ func (h *NashorsToothHandler) OnEquip(entity entity.Entity, world *ecs.World, ev
     }
 }
 
-// // OnUnequip implements itemsys.ItemHandler.
-// func (h *NashorsToothHandler) OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
-//     log.Printf("NashorsToothHandler: OnUnequip for entity %d", entity)
-    
-//     // Remove any active buff when item is unequipped
-//     if effect, exists := world.GetNashorsToothEffect(entity); exists {
-//         wasActive := effect.IsActive(0) // We don't have timestamp here, use 0
-//         if wasActive {
-//             // Remove the attack speed bonus
-//             if attack, ok := world.GetAttack(entity); ok {
-//                 attack.AddBonusPercentAttackSpeed(-effect.GetBonusAS())
-//                 log.Printf("NashorsToothHandler: Removed %.2f%% attack speed from entity %d on unequip", 
-//                     effect.GetBonusAS()*100, entity)
-//             }
-//         }
-//         effect.ResetEffects()
-//     }
-// }
+// OnUnequip implements itemsys.ItemHandler.
+func (h *NashorsToothHandler) OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
+    log.Printf("NashorsToothHandler: OnUnequip for entity %d", entity)
+
+    // Remove any active buff when item is unequipped
+    if effect, exists := world.GetNashorsToothEffect(entity); exists {
+        if effect.IsBuffActive() {
+            // The buff granted the bonus of every copy, including the one just removed. The
+            // component outlives this copy when another Nashor's is equipped, so the whole bonus
+            // is reversed here and the buff starts over on the next cast.
+            nashorsCount := 1
+            if equipment, ok := world.GetEquipment(entity); ok {
+                nashorsCount += equipment.GetItemCount(data.TFT_Item_NashorsTooth)
+            }
+            if attack, ok := world.GetAttack(entity); ok {
+                asLoss := effect.GetBonusAS() * float64(nashorsCount)
+                attack.AddBonusPercentAttackSpeed(-asLoss)
+                log.Printf("NashorsToothHandler: Removed %.2f%% attack speed from entity %d on unequip",
+                    asLoss*100, entity)
+            }
+        }
+        effect.ResetEffects()
+    }
+}
 
 // ProcessEvent implements itemsys.ItemHandler.
 func (h *NashorsToothHandler) ProcessEvent(event interface{}, entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
@@ -97,6 +118,7 @@ func (h *NashorsToothHandler) handleSpellLanded(evt eventsys.SpellLandedEvent, e
         nashorsCount := equipment.GetItemCount(data.TFT_Item_NashorsTooth)
         asGain := effect.GetBonusAS() * float64(nashorsCount)
         attack.AddBonusPercentAttackSpeed(asGain)
+        utils.RecordEffectStart(world, entity, components.UptimeNashorsTooth, evt.Timestamp)
         
         log.Printf("NashorsToothHandler (handleSpellLanded): Entity %d activated attack speed buff at %.3fs (%.1f%% for %.1fs)",
             entity, evt.Timestamp, asGain*100, effect.GetDuration())
@@ -149,6 +171,7 @@ func (h *NashorsToothHandler) handleBuffDeactivation(evt eventsys.NashorsToothDe
         attack.AddBonusPercentAttackSpeed(-asLoss)
         
         effect.DeactivateBuff()
+        utils.RecordEffectEnd(world, entity, components.UptimeNashorsTooth, evt.Timestamp)
 
         log.Printf("NashorsToothHandler (handleBuffDeactivation): Entity %d deactivated attack speed buff at %.3fs (removed %.1f%% AS)",
             entity, evt.Timestamp, asLoss*100)
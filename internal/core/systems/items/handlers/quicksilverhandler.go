@@ -2,19 +2,35 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
 	itemsys "tft-dps-simulator/internal/core/systems/items"
+	"tft-dps-simulator/internal/core/utils"
 )
 
-type QuicksilverHandler struct{}
+type QuicksilverHandler struct {
+	itemsys.NoUnequip // TODO: Remove the IsImmuneToCC marker component on unequip if implemented
+}
 
 func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_Quicksilver, &QuicksilverHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *QuicksilverHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewQuicksilverEffect(item.Effects["SpellShieldDuration"], item.Effects["ProcAttackSpeed"], item.Effects["ProcInterval"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *QuicksilverHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.QuicksilverEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 // This function is called when Quicksilver is equipped to an entity.
 // It should schedule the initial QuicksilverProcEvent.
@@ -24,6 +40,7 @@ func (h *QuicksilverHandler) OnEquip(entity entity.Entity, world *ecs.World, eve
 	effect, exists := world.GetQuicksilverEffect(entity)
 	if exists && effect.GetProcInterval() > 0 && effect.GetSpellShieldDuration() > 0 {
 		effect.ResetEffects()
+		utils.RecordEffectStart(world, entity, components.UptimeQuicksilver, 0.0)
 		// Enqueue first proc event
 		firstProcTime := effect.GetProcInterval()
 		if firstProcTime <= effect.GetSpellShieldDuration() { // Only if first proc happens before expiry
@@ -139,6 +156,7 @@ func (h *QuicksilverHandler) handleQuicksilverEnd(evt eventsys.QuicksilverEndEve
 	if effect.IsActive() {
 		log.Printf("DynamicTimeItemSystem (QuicksilverEnd): Entity %d Quicksilver duration ended at %.3fs. Marking inactive.", entity, currentTime)
 		effect.SetIsActive(false)
+		utils.RecordEffectEnd(world, entity, components.UptimeQuicksilver, currentTime)
 		// Note: Bonus AS is NOT removed here. It persists but stops stacking.
 		// If removal is desired, EquipmentManager should handle it on item removal.
 	}
@@ -2,6 +2,7 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
 
     "tft-dps-simulator/internal/core/components/debuffs"
 	"tft-dps-simulator/internal/core/components/items"
@@ -14,6 +15,16 @@ import (
 
 type RedBuffHandler struct{}
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *RedBuffHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewRedBuffEffect(item.Effects["BurnPercent"], item.Effects["HealingReductionPct"], item.Effects["Duration"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *RedBuffHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.RedBuffEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 func (h *RedBuffHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	log.Printf("RedBuffHandler: OnEquip for entity %d", entity)
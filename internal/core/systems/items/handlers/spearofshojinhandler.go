@@ -2,6 +2,8 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
+	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
@@ -9,23 +11,29 @@ import (
 	itemsys "tft-dps-simulator/internal/core/systems/items"
 )
 
-type SpearOfShojinHandler struct{}
+type SpearOfShojinHandler struct {
+	itemsys.NoUnequip
+}
 
 func init() {
     itemsys.RegisterItemHandler(data.TFT_Item_SpearOfShojin, &SpearOfShojinHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *SpearOfShojinHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewSpearOfShojinEffect(item.Effects["FlatManaRestore"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *SpearOfShojinHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.SpearOfShojinEffect{})
+}
+
 func (h *SpearOfShojinHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
     log.Printf("SpearOfShojinHandler: OnEquip for entity %d", entity)
     // No special equip logic needed - effect is handled in ProcessEvent
 }
 
-// func (h *SpearOfShojinHandler) OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
-//     log.Printf("SpearOfShojinHandler: OnUnequip for entity %d", entity)
-//     // Remove the component when item is unequipped
-//     world.RemoveComponent(entity, (*items.SpearOfShojinEffect)(nil))
-// }
-
 func (h *SpearOfShojinHandler) ProcessEvent(event interface{}, entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
     switch evt := event.(type) {
     case eventsys.AttackFiredEvent:
@@ -3,6 +3,9 @@ package itemhandlers
 import (
 	"log"
 	"math"
+	"reflect"
+
+	"tft-dps-simulator/internal/core/components/items"
 
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
@@ -11,12 +14,24 @@ import (
 	itemsys "tft-dps-simulator/internal/core/systems/items"
 )
 
-type SpiritVisageHandler struct{}
+type SpiritVisageHandler struct {
+	itemsys.NoUnequip
+}
 
 func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_SpiritVisage, &SpiritVisageHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *SpiritVisageHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewSpiritVisageEffect(item.Effects["MissingHealthHeal"], item.Effects["HealTickRate"], item.Effects["MaxHeal"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *SpiritVisageHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.SpiritVisageEffect{})
+}
+
 func (h *SpiritVisageHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
 	effect, okEffect := world.GetSpiritVisageEffect(entity)
 	if !okEffect {
@@ -57,6 +72,9 @@ func (h *SpiritVisageHandler) ProcessEvent(event interface{}, entity entity.Enti
 		previousHP := health.GetCurrentHP()
 		health.Heal(healAmountPerCount * float64(spiritVisageCount))
 		healedAmount := health.GetCurrentHP() - previousHP
+		if defenseStats, ok := world.GetDefenseStats(entity); ok {
+			defenseStats.HealingReceived += healedAmount
+		}
 
 		if healedAmount > 0.01 { // Log only if a meaningful amount was healed
 			log.Printf("SpiritVisageHandler (Tick): Entity %d healed for %.2f (%.1f%% of missing HP %.2f). HP: %.2f -> %.2f / %.2f. Timestamp: %.3fs",
@@ -2,6 +2,8 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
+	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
@@ -16,6 +18,16 @@ func init() {
     itemsys.RegisterItemHandler(data.TFT_Item_TitansResolve, &TitansResolveHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *TitansResolveHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewTitansResolveEffect(item.Effects["StackCap"], item.Effects["StackingAD"], item.Effects["StackingSP"], item.Effects["BonusResistsAtStackCap"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *TitansResolveHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.TitansResolveEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 // For Titan's Resolve, there's no immediate timed event to schedule on equip.
 // Stacks are gained through AttackLandedEvent or DamageAppliedEvent.
@@ -31,6 +43,41 @@ func (h *TitansResolveHandler) OnEquip(entity entity.Entity, world *ecs.World, e
     }
 }
 
+// OnUnequip implements itemsys.ItemHandler.
+func (h *TitansResolveHandler) OnUnequip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
+	log.Printf("TitansResolveHandler: OnUnequip for entity %d", entity)
+
+	effect, exists := world.GetTitansResolveEffect(entity)
+	if !exists {
+		return
+	}
+	// Subtract the bonuses the stacks granted from the core components
+	totalBonusAD := effect.GetCurrentBonusAD()
+	totalBonusAP := effect.GetCurrentBonusAP()
+	totalBonusArmor := effect.GetBonusArmorAtMax()
+	totalBonusMR := effect.GetBonusMRAtMax()
+	if attackComp, ok := world.GetAttack(entity); ok && totalBonusAD > 0 {
+		attackComp.AddBonusPercentAD(-totalBonusAD)
+		log.Printf("  Reversed Titan's AD: -%.2f%%. Total Bonus AD now: %.2f%%", totalBonusAD*100, attackComp.GetBonusPercentAD()*100)
+	}
+	if spellComp, ok := world.GetSpell(entity); ok && totalBonusAP > 0 {
+		spellComp.AddBonusAP(-totalBonusAP)
+		log.Printf("  Reversed Titan's AP: -%.1f. Total Bonus AP now: %.1f", totalBonusAP, spellComp.GetBonusAP())
+	}
+	if healthComp, ok := world.GetHealth(entity); ok {
+		if totalBonusArmor > 0 {
+			healthComp.AddBonusArmor(-totalBonusArmor)
+		}
+		if totalBonusMR > 0 {
+			healthComp.AddBonusMR(-totalBonusMR)
+		}
+		log.Printf("  Reversed Titan's Resists: -%.0f Armor, -%.0f MR.", totalBonusArmor, totalBonusMR)
+	}
+	// The component outlives this copy when another Titan's is equipped, so its stacks
+	// must not be reversed a second time.
+	effect.ResetStacks()
+}
+
 // ProcessEvent implements itemsys.ItemHandler.
 // This function will handle AttackLandedEvent and DamageAppliedEvent to grant stacks.
 func (h *TitansResolveHandler) ProcessEvent(event interface{}, entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
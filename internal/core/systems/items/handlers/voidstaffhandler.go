@@ -2,6 +2,7 @@ package itemhandlers
 
 import (
 	"log"
+	"reflect"
 
 	"tft-dps-simulator/internal/core/components/debuffs"
 	"tft-dps-simulator/internal/core/components/items"
@@ -18,6 +19,16 @@ func init() {
 	itemsys.RegisterItemHandler(data.TFT_Item_VoidStaff, &VoidStaffHandler{})
 }
 
+// NewEffect implements itemsys.ItemHandler.
+func (h *VoidStaffHandler) NewEffect(item *data.Item) interface{} {
+	return items.NewVoidStaffEffect(item.Effects["MRShred"], item.Effects["MRShredDuration"])
+}
+
+// EffectType implements itemsys.ItemHandler.
+func (h *VoidStaffHandler) EffectType() reflect.Type {
+	return reflect.TypeOf(items.VoidStaffEffect{})
+}
+
 // OnEquip implements itemsys.ItemHandler.
 func (h *VoidStaffHandler) OnEquip(entity entity.Entity, world *ecs.World, eventBus eventsys.EventBus) {
     log.Printf("VoidStaffHandler: OnEquip for entity %d", entity)
@@ -1,7 +1,6 @@
 package itemsys
 
 import (
-	"reflect"
 
 	"tft-dps-simulator/internal/core/components/items"
 	"tft-dps-simulator/internal/core/ecs"
@@ -27,8 +26,7 @@ func NewBaseStaticItemSystem(world *ecs.World) *BaseStaticItemSystem {
 // Output: None (modifies components directly).
 func (s *BaseStaticItemSystem) ApplyStaticItemsBonus() {
 	// Define the component types needed for this system
-	itemEffectType := reflect.TypeOf(items.ItemStaticEffect{})
-	entitiesWithItemEffect := s.world.GetEntitiesWithComponents(itemEffectType)
+	entitiesWithItemEffect := ecs.Query[items.ItemStaticEffect](s.world)
 
 	for _, entity := range entitiesWithItemEffect {
 		// // Reset component bonuses BEFORE applying static item bonuses
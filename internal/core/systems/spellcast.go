@@ -22,14 +22,13 @@ func NewSpellCastSystem(world *ecs.World, bus eventsys.EventBus) *SpellCastSyste
 	}
 }
 
-// CanHandle checks if the system can process the given event type.
-func (s *SpellCastSystem) CanHandle(evt interface{}) bool {
-	switch evt.(type) {
-	case eventsys.SpellCastCycleStartEvent, eventsys.SpellLandedEvent, eventsys.SpellRecoveryEndEvent: // Add more as needed
-		return true
-	default:
-		return false
-	}
+// Subscriptions lists the events the system processes.
+func (s *SpellCastSystem) Subscriptions() []eventsys.Subscription {
+	return eventsys.Subscribe(eventsys.PriorityCombat,
+		eventsys.SpellCastCycleStartEvent{},
+		eventsys.SpellLandedEvent{},
+		eventsys.SpellRecoveryEndEvent{},
+	)
 }
 
 // HandleEvent processes events related to the spell cast cycle.
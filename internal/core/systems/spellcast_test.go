@@ -35,7 +35,7 @@ package systems_test
 //     BeforeEach(func() {
 //         world = ecs.NewWorld()
 //         eventBus = NewMockEventBus()
-//         championFactory = factory.NewChampionFactory(world)
+//         championFactory = factory.NewChampionFactory(world, testData)
 //         spellCastSystem = systems.NewSpellCastSystem(world, eventBus)
 
 //         // --- Create Player (Blue Golem) ---
@@ -3,7 +3,6 @@ package systems
 import (
 	"log"
 	"math"
-	"reflect"
 
 	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/data"
@@ -24,14 +23,11 @@ func NewStatCalculationSystem(world *ecs.World) *StatCalculationSystem {
 	return &StatCalculationSystem{world: world}
 }
 
-// CanHandle checks if the system can process the given event type.
-func (s *StatCalculationSystem) CanHandle(evt interface{}) bool {
-    switch evt.(type) {
-    case eventsys.RecalculateStatsEvent:
-        return true
-    default:
-        return false
-    }
+// Subscriptions lists the events the system processes.
+func (s *StatCalculationSystem) Subscriptions() []eventsys.Subscription {
+    return eventsys.Subscribe(eventsys.PriorityStats,
+        eventsys.RecalculateStatsEvent{},
+    )
 }
 
 // HandleEvent processes incoming events, specifically RecalculateStatsEvent.
@@ -47,19 +43,10 @@ func (s *StatCalculationSystem) ApplyStaticBonusStats() {
 	// Define component types needed. We need entities that have stats to calculate.
 	// Querying for just one core stat component like Health might be sufficient,
 	// as entities with stats usually have multiple stat components.
-	healthType := reflect.TypeOf(components.Health{})
-	attackType := reflect.TypeOf(components.Attack{})
-	manaType := reflect.TypeOf(components.Mana{})
-
-	entities := s.world.GetEntitiesWithComponents(healthType, manaType, attackType)
+	entities := ecs.Query3[components.Health, components.Mana, components.Attack](s.world)
 
 	for _, entity := range entities {
-		healthType := reflect.TypeOf(components.Health{})
-		attackType := reflect.TypeOf(components.Attack{})
-		manaType := reflect.TypeOf(components.Mana{})
-		critType := reflect.TypeOf(components.Crit{})
-
-		entities := s.world.GetEntitiesWithComponents(healthType, manaType, attackType, critType)
+		entities := ecs.Query4[components.Health, components.Mana, components.Attack, components.Crit](s.world)
 
 		for _, entity := range entities {
 			s.calculateHealthStats(entity)
@@ -226,8 +213,7 @@ func (s *StatCalculationSystem) calculateCritStats(entity entity.Entity) {
 	totalCritItems := numIE + numJG
 
 	// Check for trait source of ability crit
-	traitCritMarkerType := reflect.TypeOf(components.CanAbilityCritFromTraits{})
-	_, hasTraitCritMarker := s.world.GetComponent(entity, traitCritMarkerType)
+	hasTraitCritMarker := ecs.Has[components.CanAbilityCritFromTraits](s.world, entity)
 
 	// Determine how many IE/JG grant the bonus damage
 	numBonusGrantingItems := 0
@@ -17,23 +17,21 @@ func TestSystems(t *testing.T) {
 	ginkgo.RunSpecs(t, "Systems Suite")
 }
 
+// testData is the game data shared by every spec in the suite.
+var testData *data.DataSet
+
 var _ = ginkgo.BeforeSuite(func() {
 	// Load item data once for the entire manager suite
 	// Adjust the path to your actual item data file
 	dataDir := "../../assets"
 	fileName := "en_us_pbe.json"
 	filePath := filepath.Join(dataDir, fileName)
-	tftData, err := data.LoadSetDataFromFile(filePath, "TFTSet14")
+	var err error
+	testData, err = data.LoadDataSet(filePath, "TFTSet14", "pbe")
 	if err != nil {
 		log.Printf("Error loading set data: %v\n", err)
 		os.Exit(1)
 	}
-	data.InitializeChampions(tftData)
-
-	data.InitializeTraits(tftData)
-	data.InitializeSetActiveAugments(tftData, filePath)
-
-	data.InitializeSetActiveItems(tftData, filePath)
 
 	gomega.Expect(err).NotTo(gomega.HaveOccurred(), "Failed to load item data")
 })
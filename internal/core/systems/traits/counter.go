@@ -2,8 +2,6 @@ package traitsys
 
 import (
 	"log"
-	"reflect"
-	"sort"
 
 	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/data"
@@ -13,13 +11,15 @@ import (
 // TraitCounterSystem calculates active trait tiers based on unit counts.
 type TraitCounterSystem struct {
     world      *ecs.World
+    dataSet    *data.DataSet // Trait definitions and breakpoints
     traitState *TeamTraitState
 }
 
 // NewTraitCounterSystem creates a new TraitCounterSystem.
-func NewTraitCounterSystem(world *ecs.World, state *TeamTraitState) *TraitCounterSystem {
+func NewTraitCounterSystem(world *ecs.World, dataSet *data.DataSet, state *TeamTraitState) *TraitCounterSystem {
     return &TraitCounterSystem{
         world:      world,
+        dataSet:    dataSet,
         traitState: state,
     }
 }
@@ -30,10 +30,7 @@ func (s *TraitCounterSystem) UpdateCountsAndTiers() {
     log.Println("TraitCounterSystem: Updating counts and tiers...")
     s.traitState.ResetAll() // Clear previous counts and tiers
 
-    teamType := reflect.TypeOf(components.Team{})
-    traitsType := reflect.TypeOf(components.Traits{})
-    ChampionInfoType := reflect.TypeOf(components.ChampionInfo{})
-    entities := s.world.GetEntitiesWithComponents(teamType, traitsType, ChampionInfoType) 
+    entities := ecs.Query3[components.Team, components.Traits, components.ChampionInfo](s.world)
 
     // 1. Identify unique champions per team and their traits
     uniqueChampsPerTeam := make(map[int]map[string][]string) // teamID -> championApiName -> traitsList
@@ -65,7 +62,7 @@ func (s *TraitCounterSystem) UpdateCountsAndTiers() {
         for champApiName, traitsList := range championsMap {
             for _, traitName := range traitsList {
                 // Ensure the trait exists in the loaded data
-                if _, exists := data.Traits[traitName]; exists {
+                if s.dataSet.GetTraitByName(traitName) != nil {
                     s.traitState.unitCounts[teamID][traitName]++
                 } else {
                     // Log warning only once per unique champion type if needed, but logging here is fine too.
@@ -82,14 +79,10 @@ func (s *TraitCounterSystem) UpdateCountsAndTiers() {
     for teamID, traitCounts := range s.traitState.unitCounts {
         log.Printf("TraitCounterSystem: Calculating active tiers for Team %d", teamID)
         for traitName, count := range traitCounts {
-            traitData := data.Traits[traitName]
+            traitData := s.dataSet.GetTraitByName(traitName)
 
             activeTierIndex := -1 // Default to inactive
-            // Sort effects by MinUnits to ensure correct tier detection
-            // Optimization: This sort could happen once globally if traitData.Effects is never modified.
-            sort.SliceStable(traitData.Effects, func(i, j int) bool {
-                return traitData.Effects[i].MinUnits < traitData.Effects[j].MinUnits
-            })
+            // Effects are sorted by MinUnits when the data set is built
 
             for i, effect := range traitData.Effects {
                 if count >= effect.MinUnits {
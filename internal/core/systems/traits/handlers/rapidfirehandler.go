@@ -3,14 +3,15 @@ package traithandlers
 import (
 	"log"
 	"math"
-	"reflect" // Needed for component type
 
+	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/components/traits"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
 	traitsys "tft-dps-simulator/internal/core/systems/traits"
+	"tft-dps-simulator/internal/core/utils"
 )
 
 // RapidfireHandler implements dynamic logic for the Rapidfire trait using a dedicated component.
@@ -49,7 +50,7 @@ func (h *RapidfireHandler) OnActivate(teamID int, effect data.Effect, world *ecs
 
 		// Add RapidfireEffect component ONLY to champions with the Rapidfire trait
 		if traitComp, ok := world.GetTraits(entity); ok && traitComp.HasTrait(data.TFT14_Rapidfire) {
-			if !world.HasComponent(entity, reflect.TypeOf(traits.RapidfireEffect{})) {
+			if !ecs.Has[traits.RapidfireEffect](world, entity) {
 				rapidfireEffect := traits.NewRapidfireEffect(maxStacks, asPerStack)
 				world.AddComponent(entity, rapidfireEffect)
 				log.Printf("RapidfireHandler (Team %d): Added RapidfireEffect component to Entity %d", teamID, entity)
@@ -76,29 +77,33 @@ func (h *RapidfireHandler) Handle(event interface{}, entity entity.Entity, world
 	}
 
 	// Increment stacks within the component
-	_, reachedMax := rapidfireEffect.IncrementStacks()
-	if !reachedMax {
-		log.Printf("RapidfireHandler: Entity %d attacked. Stacks: %d/%d.",
-			entity, rapidfireEffect.GetCurrentStacks(), rapidfireEffect.GetMaxStacks())
-		
-		attack, ok := world.GetAttack(entity)
-		if !ok {
-			log.Printf("RapidfireHandler: Entity %d does not have Attack component.", entity)
-			return
-		}
-		attack.AddBonusPercentAttackSpeed(rapidfireEffect.GetAttackSpeedPerStack())
+	stackAdded, reachedMax := rapidfireEffect.IncrementStacks()
+	if !stackAdded {
+		return // Already at max stacks
+	}
+	log.Printf("RapidfireHandler: Entity %d attacked. Stacks: %d/%d.",
+		entity, rapidfireEffect.GetCurrentStacks(), rapidfireEffect.GetMaxStacks())
 
-		// --- Enqueue RecalculateStatsEvent ---
-		recalcEvent := eventsys.RecalculateStatsEvent{
-			Entity:    entity,
-			Timestamp: attackEvt.Timestamp,
-		}
-		// Enqueue immediately at the current time
-		eventBus.Enqueue(recalcEvent, attackEvt.Timestamp)
-		log.Printf("RapidfireHandler: Enqueued RecalculateStatsEvent for Entity %d at t=%.4f", entity, attackEvt.Timestamp)
-	} else {
-		log.Printf("RapidfireHandler: Entity %d reached max stacks (%d). No further stack increment.",
-			entity, rapidfireEffect.GetMaxStacks())
+	attack, ok := world.GetAttack(entity)
+	if !ok {
+		log.Printf("RapidfireHandler: Entity %d does not have Attack component.", entity)
+		return
+	}
+	attack.AddBonusPercentAttackSpeed(rapidfireEffect.GetAttackSpeedPerStack())
+
+	// --- Enqueue RecalculateStatsEvent ---
+	recalcEvent := eventsys.RecalculateStatsEvent{
+		Entity:    entity,
+		Timestamp: attackEvt.Timestamp,
+	}
+	// Enqueue immediately at the current time
+	eventBus.Enqueue(recalcEvent, attackEvt.Timestamp)
+	log.Printf("RapidfireHandler: Enqueued RecalculateStatsEvent for Entity %d at t=%.4f", entity, attackEvt.Timestamp)
+
+	if reachedMax {
+		// Max stacks start with the attack that adds the last stack
+		log.Printf("RapidfireHandler: Entity %d reached max stacks (%d).", entity, rapidfireEffect.GetMaxStacks())
+		utils.RecordEffectStart(world, entity, components.UptimeRapidfireMaxStacks, attackEvt.Timestamp)
 	}
 }
 
@@ -111,10 +116,8 @@ func (h *RapidfireHandler) OnDeactivate(teamID int, effect data.Effect, world *e
 // Reset removes RapidfireEffect components from all entities.
 func (h *RapidfireHandler) Reset(world *ecs.World) {
 	log.Printf("RapidfireHandler: Resetting all states.")
-	rapidfireEffectType := reflect.TypeOf(traits.RapidfireEffect{})
-	entities := world.GetEntitiesWithComponents(rapidfireEffectType)
-	for _, entity := range entities {
-		world.RemoveComponent(entity, rapidfireEffectType)
+	for _, entity := range ecs.Query[traits.RapidfireEffect](world) {
+		ecs.Remove[traits.RapidfireEffect](world, entity)
 		log.Printf("  Rapidfire: Removed RapidfireEffect component from Entity %d during reset.", entity)
 	}
 	// Static bonuses are assumed to be reset by the main stat reset mechanism before applying new ones.
@@ -58,4 +58,21 @@ func (tts *TeamTraitState) GetActiveTier(teamID int, traitApiName string) int {
 // GetActiveTiers returns the active tiers for all traits for a team.
 func (tts *TeamTraitState) GetActiveTiers() map[int]map[string]int {
 	return tts.activeTier
-}
\ No newline at end of file
+}
+// Clone returns a deep copy of the trait state.
+func (tts *TeamTraitState) Clone() *TeamTraitState {
+	clone := NewTeamTraitState()
+	for teamID, counts := range tts.unitCounts {
+		clone.unitCounts[teamID] = make(map[string]int, len(counts))
+		for trait, count := range counts {
+			clone.unitCounts[teamID][trait] = count
+		}
+	}
+	for teamID, tiers := range tts.activeTier {
+		clone.activeTier[teamID] = make(map[string]int, len(tiers))
+		for trait, tier := range tiers {
+			clone.activeTier[teamID][trait] = tier
+		}
+	}
+	return clone
+}
@@ -3,7 +3,6 @@ package utils
 
 import (
 	"container/heap"
-	"math/rand"
 	"reflect"
 
 	eventsys "tft-dps-simulator/internal/core/systems/events"
@@ -13,47 +12,44 @@ import (
 type MockEventBus struct {
     // Use EventItems similar to the real queue
     queue    eventsys.EventQueue
-    handlers []eventsys.EventHandler
-    rng      *rand.Rand
+    router   *eventsys.Router
+    sequence uint64
     // Store processed events for inspection if needed
     processedEvents []*eventsys.EventItem
 }
 
 // NewMockEventBus creates a new MockEventBus.
 func NewMockEventBus() *MockEventBus {
-    // Seed random number generator for jitter consistency in tests if needed
-    source := rand.NewSource(1) // Use a fixed seed for deterministic tests
     eq := make(eventsys.EventQueue, 0)
     heap.Init(&eq) // Initialize the heap
     return &MockEventBus{
         queue:           eq,
-        handlers:        make([]eventsys.EventHandler, 0),
-        rng:             rand.New(source),
+        router:          eventsys.NewRouter(),
         processedEvents: make([]*eventsys.EventItem, 0),
     }
 }
 
-// Enqueue adds an event to the mock queue with timestamp and jitter.
+// Enqueue adds an event to the mock queue, ordered like the real queue without jitter.
 // Matches the EventEnqueuer interface.
 func (m *MockEventBus) Enqueue(evt interface{}, timestamp float64) {
-    // Add small random jitter based on design doc U(-10^-5, +10^-5)
-    jitter := (m.rng.Float64()*2 - 1) * 1e-5
-    enqueueTimestamp := timestamp + jitter
+    m.sequence++
     item := &eventsys.EventItem{
         Event:            evt,
         Timestamp:        timestamp,
-        EnqueueTimestamp: enqueueTimestamp,
+        EnqueueTimestamp: timestamp,
+        Phase:            eventsys.EventPhase(evt),
+        Sequence:         m.sequence,
         // index is managed by heap
     }
     heap.Push(&m.queue, item)
 }
 
-// RegisterHandler adds a handler.
+// RegisterHandler subscribes a handler to the events in its Subscriptions.
 func (m *MockEventBus) RegisterHandler(h eventsys.EventHandler) {
-    m.handlers = append(m.handlers, h)
+    m.router.Register(h)
 }
 
-// Dequeue removes and returns the next event based on EnqueueTimestamp.
+// Dequeue removes and returns the next event in queue order.
 // Returns nil if the queue is empty.
 func (m *MockEventBus) Dequeue() *eventsys.EventItem {
     if m.queue.Len() == 0 {
@@ -79,10 +75,8 @@ func (m *MockEventBus) Dispatch(evt interface{}) {
 	if evt == nil {
 		return
 	}
-	for _, h := range m.handlers {
-		if h.CanHandle(evt) {
-			h.HandleEvent(evt)
-		}
+	for _, h := range m.router.Handlers(evt) {
+		h.HandleEvent(evt)
 	}
 }
 
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"fmt"
-	"reflect"
 
 	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/data"
@@ -79,7 +78,7 @@ func PrintChampionStats(world *ecs.World, entity entity.Entity) {
 }
 
 func PrintTeamStats(world *ecs.World) {
-	for _, entity := range world.GetEntitiesWithComponents(reflect.TypeOf(components.Team{})) {
+	for _, entity := range ecs.Query[components.Team](world) {
 		team, ok := world.GetTeam(entity)
 		if ok && team.ID == 0 {
 			PrintChampionStats(world, entity)
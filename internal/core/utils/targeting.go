@@ -3,7 +3,6 @@ package utils
 import (
 	"log"
 	"math"
-	"reflect"
 
 	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/ecs"
@@ -20,13 +19,8 @@ func FindNearestEnemy(world *ecs.World, source entity.Entity, sourceTeamID int)
 		return 0, false // Source has no position
 	}
 
-	// Define component types needed for GetEntitiesWithComponents (still uses reflect)
-	posType := reflect.TypeOf(components.Position{})
-	healthType := reflect.TypeOf(components.Health{})
-	teamType := reflect.TypeOf(components.Team{})
-
-	// Get all entities that have Position, Health, and Team components
-	entities := world.GetEntitiesWithComponents(posType, healthType, teamType)
+	// Get all entities that have Position, Health, and Team components (cached by the world)
+	entities := ecs.Query3[components.Position, components.Health, components.Team](world)
 
 	// Filter for entities specifically on Team 1
 	var potentialTargets []entity.Entity
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/ecs"
+	"tft-dps-simulator/internal/core/entity"
+)
+
+// getOrAddEffectUptime returns the EffectUptime component for the entity, adding one if missing.
+func getOrAddEffectUptime(world *ecs.World, e entity.Entity) *components.EffectUptime {
+	uptime, ok := world.GetEffectUptime(e)
+	if !ok {
+		uptime = components.NewEffectUptime()
+		world.AddComponent(e, uptime)
+	}
+	return uptime
+}
+
+// RecordEffectStart marks the named buff or debuff as active on the entity from timestamp.
+func RecordEffectStart(world *ecs.World, e entity.Entity, name string, timestamp float64) {
+	getOrAddEffectUptime(world, e).StartEffect(name, timestamp)
+}
+
+// RecordEffectEnd marks the named buff or debuff as no longer active on the entity at timestamp.
+func RecordEffectEnd(world *ecs.World, e entity.Entity, name string, timestamp float64) {
+	if uptime, ok := world.GetEffectUptime(e); ok {
+		uptime.EndEffect(name, timestamp)
+	}
+}
+
+// CloseAllEffectUptimes closes every open uptime interval in the world at timestamp.
+func CloseAllEffectUptimes(world *ecs.World, timestamp float64) {
+	ecs.Each(world, func(_ entity.Entity, uptime *components.EffectUptime) {
+		uptime.CloseAll(timestamp)
+	})
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math"
@@ -16,8 +17,19 @@ import (
 
 type Service interface {
 	Health() map[string]string
+	// Get returns the value stored at key, or ErrKeyNotFound.
+	Get(ctx context.Context, key string) (string, error)
+	// Set stores value at key. A ttl of 0 means the key does not expire.
+	Set(ctx context.Context, key string, value string, ttl time.Duration) error
+	// ListPush prepends value to the list at key, keeping at most maxLen entries (0 for no limit).
+	ListPush(ctx context.Context, key string, value string, maxLen int64) error
+	// ListRange returns list entries between start and stop (inclusive, Redis LRANGE semantics).
+	ListRange(ctx context.Context, key string, start, stop int64) ([]string, error)
 }
 
+// ErrKeyNotFound is returned by Get when the key does not exist.
+var ErrKeyNotFound = errors.New("key not found")
+
 type service struct {
 	db *redis.Client
 }
@@ -66,6 +78,36 @@ func (s *service) Health() map[string]string {
 	return stats
 }
 
+// Get returns the value stored at key, or ErrKeyNotFound.
+func (s *service) Get(ctx context.Context, key string) (string, error) {
+	value, err := s.db.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrKeyNotFound
+	}
+	return value, err
+}
+
+// Set stores value at key. A ttl of 0 means the key does not expire.
+func (s *service) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	return s.db.Set(ctx, key, value, ttl).Err()
+}
+
+// ListPush prepends value to the list at key, keeping at most maxLen entries (0 for no limit).
+func (s *service) ListPush(ctx context.Context, key string, value string, maxLen int64) error {
+	pipe := s.db.TxPipeline()
+	pipe.LPush(ctx, key, value)
+	if maxLen > 0 {
+		pipe.LTrim(ctx, key, 0, maxLen-1)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ListRange returns list entries between start and stop (inclusive, Redis LRANGE semantics).
+func (s *service) ListRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+	return s.db.LRange(ctx, key, start, stop).Result()
+}
+
 // checkRedisHealth checks the health of the Redis server and adds the relevant statistics to the stats map.
 func (s *service) checkRedisHealth(ctx context.Context, stats map[string]string) map[string]string {
 	// Ping the Redis server to check its availability.
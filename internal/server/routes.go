@@ -1,9 +1,19 @@
 package server
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
+	"tft-dps-simulator/internal/boards"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
 	"tft-dps-simulator/internal/service" 
 
 	"github.com/gofiber/fiber/v2"
@@ -28,7 +38,14 @@ func (s *FiberServer) RegisterFiberRoutes() {
 	
 	// Use real implementation
 	simulationGroup.Post("/run", s.HandleRunSimulation)
+
+	// Stream events and progress as Server-Sent Events
+	simulationGroup.Post("/stream", s.HandleStreamSimulation)
 	
+	// Record a run as a replayable archive, and replay an uploaded archive
+	simulationGroup.Post("/archive", s.HandleRecordArchive)
+	simulationGroup.Post("/replay", s.HandleReplayArchive)
+
 	// Add mock endpoint for testing
 	simulationGroup.Post("/mock-run", func(c *fiber.Ctx) error {
 		// Parse request just to validate it (optional)
@@ -50,6 +67,40 @@ func (s *FiberServer) RegisterFiberRoutes() {
 		return c.Status(fiber.StatusOK).JSON(resp)
 	})
 
+	// Asynchronous simulation jobs
+	jobsGroup := apiV1.Group("/jobs")
+	jobsGroup.Post("/", s.HandleCreateJob)
+	jobsGroup.Get("/:id", s.HandleGetJob)
+	jobsGroup.Delete("/:id", s.HandleCancelJob)
+
+	// Saved boards, shareable by short code
+	boardsGroup := apiV1.Group("/boards")
+	boardsGroup.Post("/", s.HandleSaveBoard)
+	boardsGroup.Get("/", s.HandleListBoards)
+	boardsGroup.Get("/:code", s.HandleGetBoard)
+	boardsGroup.Get("/:code/result", s.HandleGetBoardResult)
+
+	// Read-only game data catalog
+	catalogGroup := apiV1.Group("/catalog")
+	catalogGroup.Get("/champions", s.HandleCatalogChampions)
+	catalogGroup.Get("/items", s.HandleCatalogItems)
+	catalogGroup.Get("/traits", s.HandleCatalogTraits)
+	catalogGroup.Get("/augments", s.HandleCatalogAugments)
+
+	// Which items, traits and abilities the simulator models
+	apiV1.Get("/coverage", s.HandleCoverage)
+
+	// Loaded sets and patches
+	apiV1.Get("/data/versions", s.HandleDataVersions)
+
+	// JSON Schema of streamed and archived events
+	apiV1.Get("/events/schema", s.HandleEventSchema)
+
+	// Operator endpoints, require the admin bearer token
+	adminGroup := apiV1.Group("/admin", s.requireAdmin)
+	adminGroup.Post("/data/reload", s.HandleReloadData)
+	adminGroup.Get("/data/reload", s.HandleReloadStatus)
+
 	// Existing routes (keep them if needed, or move under API group)
 	// s.App.Get("/", s.HelloWorldHandler)
 	s.App.Get("/health", s.healthHandler)
@@ -66,17 +117,15 @@ func (s *FiberServer) HandleRunSimulation(c *fiber.Ctx) error {
 		})
 	}
 
-	// 2. Basic Validation (Example: check if champions are provided)
-	if len(req.BoardChampions) == 0 {
-		log.Println("Validation Error: No board champions provided")
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "BoardChampions array cannot be empty",
-		})
+	// 2. Validate (lenient requests get warnings instead of a 400)
+	warnings, err := s.simService.CheckRequest(req)
+	if err != nil {
+		return validationErrorResponse(c, err)
 	}
 
 	// 3. Call Simulation Service
 	log.Printf("Calling SimulationService with %d champions", len(req.BoardChampions))
-	resp, err := s.simService.RunSimulation(req.BoardChampions)
+	resp, err := s.simService.RunSimulationWithContext(c.Context(), req)
 	if err != nil {
 		log.Printf("Error running simulation: %v", err)
 		// Determine appropriate status code based on error type if possible
@@ -84,12 +133,504 @@ func (s *FiberServer) HandleRunSimulation(c *fiber.Ctx) error {
 			"error": fmt.Sprintf("Simulation failed: %v", err),
 		})
 	}
+	resp.Warnings = append(warnings, resp.Warnings...)
 
 	// 4. Send Response
 	log.Println("Simulation successful, sending response.")
 	return c.Status(fiber.StatusOK).JSON(resp)
 }
 
+// HandleRecordArchive runs a simulation and returns it as a downloadable archive file.
+func (s *FiberServer) HandleRecordArchive(c *fiber.Ctx) error {
+	var req service.RunSimulationRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cannot parse request body",
+		})
+	}
+	if _, err := s.simService.CheckRequest(req); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	archive, err := s.simService.RecordArchive(c.Context(), req)
+	if err != nil {
+		log.Printf("Error recording simulation archive: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fmt.Sprintf("Simulation failed: %v", err),
+		})
+	}
+	c.Attachment(fmt.Sprintf("tftsim-%s-%d.json", archive.DataVersion.SetID, archive.Request.Seed))
+	return c.Status(fiber.StatusOK).JSON(archive)
+}
+
+// HandleReplayArchive replays an uploaded archive and reports whether it reproduced the recorded results.
+func (s *FiberServer) HandleReplayArchive(c *fiber.Ctx) error {
+	archive, err := service.ReadArchive(bytes.NewReader(c.Body()))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if _, err := s.simService.CheckRequest(archive.Request); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	report, err := s.simService.ReplayArchive(c.Context(), archive)
+	if err != nil {
+		log.Printf("Error replaying archive: %v", err)
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error": fmt.Sprintf("Replay failed: %v", err),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(report)
+}
+
+// streamSimulationTimeout bounds a streamed run, like the per-job timeout bounds a job.
+const streamSimulationTimeout = 2 * time.Minute
+
+// HandleStreamSimulation runs the simulation and streams it back as Server-Sent Events:
+// an "event" per processed simulation event, periodic "progress" updates, and a final
+// "result" (or "error"). Closing the connection cancels the simulation.
+func (s *FiberServer) HandleStreamSimulation(c *fiber.Ctx) error {
+	var req service.RunSimulationRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cannot parse request body",
+		})
+	}
+
+	warnings, err := s.simService.CheckRequest(req)
+	if err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// The writer outlives the handler (and its request context), so the run gets its own deadline.
+		ctx, cancel := context.WithTimeout(context.Background(), streamSimulationTimeout)
+		defer cancel()
+
+		// A failed write means the client went away; stop the simulation.
+		handler := service.SimulationStreamHandler{
+			OnEvent: func(evt service.ArchivedEvent) bool {
+				return writeSSE(w, "event", evt) == nil
+			},
+			OnProgress: func(progress service.SimulationProgress) bool {
+				return writeSSE(w, "progress", progress) == nil
+			},
+		}
+
+		log.Printf("Streaming simulation with %d champions", len(req.BoardChampions))
+		resp, err := s.simService.StreamSimulation(ctx, req, handler)
+		if err != nil {
+			log.Printf("Error streaming simulation: %v", err)
+			writeSSE(w, "error", fiber.Map{"error": fmt.Sprintf("Simulation failed: %v", err)})
+			return
+		}
+		resp.Warnings = append(warnings, resp.Warnings...)
+		writeSSE(w, "result", resp)
+	})
+
+	return nil
+}
+
+// writeSSE writes a single Server-Sent Event with a JSON payload and flushes it.
+func writeSSE(w *bufio.Writer, eventName string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("Error marshalling %s SSE payload: %v", eventName, err)
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventName, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// HandleCreateJob queues a simulation request and returns the job (202 Accepted).
+func (s *FiberServer) HandleCreateJob(c *fiber.Ctx) error {
+	var req service.RunSimulationRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cannot parse request body",
+		})
+	}
+
+	if _, err := s.simService.CheckRequest(req); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	job, err := s.jobManager.Submit(req)
+	if err != nil {
+		log.Printf("Error submitting job: %v", err)
+		return c.Status(submitJobErrorStatus(err)).JSON(fiber.Map{
+			"error": fmt.Sprintf("Cannot create job: %v", err),
+		})
+	}
+
+	c.Location(fmt.Sprintf("/api/v1/jobs/%s", job.ID))
+	return c.Status(fiber.StatusAccepted).JSON(job)
+}
+
+// submitJobErrorStatus is 503 when the job manager cannot take more work right now, 500 otherwise.
+func submitJobErrorStatus(err error) int {
+	if errors.Is(err, service.ErrJobQueueFull) || errors.Is(err, service.ErrJobManagerStopped) {
+		return fiber.StatusServiceUnavailable
+	}
+	return fiber.StatusInternalServerError
+}
+
+// HandleGetJob returns the status (and result, once completed) of a job.
+func (s *FiberServer) HandleGetJob(c *fiber.Ctx) error {
+	job, err := s.jobManager.Get(c.Params("id"))
+	if err != nil {
+		return jobErrorResponse(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(job)
+}
+
+// HandleCancelJob cancels a queued or running job.
+func (s *FiberServer) HandleCancelJob(c *fiber.Ctx) error {
+	job, err := s.jobManager.Cancel(c.Params("id"))
+	if err != nil {
+		return jobErrorResponse(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(job)
+}
+
+// validationErrorResponse returns a 400 listing every field error found in the request.
+func validationErrorResponse(c *fiber.Ctx, err error) error {
+	var validationErrs service.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	log.Printf("Validation Error: %v", validationErrs)
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":  "Invalid simulation request",
+		"errors": validationErrs,
+	})
+}
+
+func jobErrorResponse(c *fiber.Ctx, err error) error {
+	if errors.Is(err, service.ErrJobNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Job not found",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
+
+// saveBoardRequest is a simulation request with an optional display name.
+type saveBoardRequest struct {
+	service.RunSimulationRequest
+	Name string `json:"name,omitempty"`
+}
+
+// HandleSaveBoard stores a board and returns it with its short code (201 Created).
+func (s *FiberServer) HandleSaveBoard(c *fiber.Ctx) error {
+	var req saveBoardRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cannot parse request body",
+		})
+	}
+
+	if _, err := s.simService.CheckRequest(req.RunSimulationRequest); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	board := boards.SavedBoard{
+		Name:      req.Name,
+		Request:   req.RunSimulationRequest,
+		CreatedAt: time.Now().UTC(),
+	}
+	if s.simService != nil {
+		if hash, err := s.simService.BoardHash(req.RunSimulationRequest); err == nil {
+			board.BoardHash = hash
+		}
+	}
+
+	// Codes are random; retry a few times on the unlikely collision.
+	for attempt := 0; attempt < 5 && board.Code == ""; attempt++ {
+		code, err := boards.NewCode()
+		if err == nil {
+			_, err = s.boardRepo.Load(c.Context(), code)
+		}
+		switch {
+		case errors.Is(err, boards.ErrBoardNotFound):
+			board.Code = code
+		case err != nil:
+			log.Printf("Error saving board: %v", err)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": fmt.Sprintf("Cannot save board: %v", err),
+			})
+		}
+	}
+	if board.Code == "" {
+		log.Println("Error saving board: could not allocate a board code")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Cannot allocate board code",
+		})
+	}
+
+	if err := s.boardRepo.Save(c.Context(), board); err != nil {
+		log.Printf("Error saving board: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fmt.Sprintf("Cannot save board: %v", err),
+		})
+	}
+
+	log.Printf("Saved board %s (%d champions)", board.Code, len(req.BoardChampions))
+	c.Location(fmt.Sprintf("/api/v1/boards/%s", board.Code))
+	return c.Status(fiber.StatusCreated).JSON(board)
+}
+
+// HandleListBoards returns the most recently saved boards (?limit=, default 20).
+func (s *FiberServer) HandleListBoards(c *fiber.Ctx) error {
+	limit := 20
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "limit must be a positive integer",
+			})
+		}
+		limit = parsed
+	}
+	if limit > boards.MaxRecentBoards {
+		limit = boards.MaxRecentBoards
+	}
+
+	recent, err := s.boardRepo.ListRecent(c.Context(), limit)
+	if err != nil {
+		log.Printf("Error listing boards: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(recent)
+}
+
+// HandleGetBoard returns the saved board for a short code.
+func (s *FiberServer) HandleGetBoard(c *fiber.Ctx) error {
+	board, err := s.boardRepo.Load(c.Context(), c.Params("code"))
+	if err != nil {
+		return boardErrorResponse(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(board)
+}
+
+// HandleGetBoardResult returns the simulation result for a saved board.
+// Results come from the result cache when the board was simulated before.
+func (s *FiberServer) HandleGetBoardResult(c *fiber.Ctx) error {
+	board, err := s.boardRepo.Load(c.Context(), c.Params("code"))
+	if err != nil {
+		return boardErrorResponse(c, err)
+	}
+
+	// Data may have changed since the board was saved
+	warnings, err := s.simService.CheckRequest(board.Request)
+	if err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	resp, err := s.simService.RunSimulationWithContext(c.Context(), board.Request)
+	if err != nil {
+		log.Printf("Error running simulation for board %s: %v", board.Code, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fmt.Sprintf("Simulation failed: %v", err),
+		})
+	}
+	resp.Warnings = append(warnings, resp.Warnings...)
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+func boardErrorResponse(c *fiber.Ctx, err error) error {
+	if errors.Is(err, boards.ErrBoardNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Board not found",
+		})
+	}
+	log.Printf("Error loading board: %v", err)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
+
+// HandleCatalogChampions lists champions, filtered by ?cost= and ?trait=.
+// Like every catalog endpoint, ?setId= and ?patch= select the data set.
+func (s *FiberServer) HandleCatalogChampions(c *fiber.Ctx) error {
+	ds, err := s.simService.DataSet(c.Query("setId"), c.Query("patch"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	filter := service.ChampionFilter{Trait: c.Query("trait")}
+	if raw := c.Query("cost"); raw != "" {
+		cost, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "cost must be an integer",
+			})
+		}
+		filter.Cost = cost
+	}
+	return c.Status(fiber.StatusOK).JSON(service.ListCatalogChampions(ds, filter))
+}
+
+// HandleCatalogItems lists items, filtered by ?tag=, ?component=, ?trait= and ?hasHandler=.
+func (s *FiberServer) HandleCatalogItems(c *fiber.Ctx) error {
+	ds, err := s.simService.DataSet(c.Query("setId"), c.Query("patch"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	filter, err := parseItemFilter(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(service.ListCatalogItems(ds, filter))
+}
+
+// HandleCatalogAugments lists augments, with the same filters as HandleCatalogItems.
+func (s *FiberServer) HandleCatalogAugments(c *fiber.Ctx) error {
+	ds, err := s.simService.DataSet(c.Query("setId"), c.Query("patch"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	filter, err := parseItemFilter(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(service.ListCatalogAugments(ds, filter))
+}
+
+// HandleCatalogTraits lists traits, filtered by ?hasHandler=.
+func (s *FiberServer) HandleCatalogTraits(c *fiber.Ctx) error {
+	ds, err := s.simService.DataSet(c.Query("setId"), c.Query("patch"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	hasHandler, err := parseOptionalBool(c.Query("hasHandler"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "hasHandler must be true or false",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(service.ListCatalogTraits(ds, service.TraitFilter{HasHandler: hasHandler}))
+}
+
+// HandleCoverage returns the simulation coverage report for the loaded set.
+func (s *FiberServer) HandleCoverage(c *fiber.Ctx) error {
+	ds, err := s.simService.DataSet(c.Query("setId"), c.Query("patch"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(service.BuildCoverageReport(ds))
+}
+
+// HandleDataVersions lists the loaded sets and patches that requests can select.
+func (s *FiberServer) HandleDataVersions(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(s.simService.DataSets())
+}
+
+// HandleEventSchema serves the JSON Schema of serialized events, one branch per versioned event type.
+func (s *FiberServer) HandleEventSchema(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(eventsys.Schema(), "application/schema+json")
+}
+
+// requireAdmin rejects requests without the configured admin bearer token.
+func (s *FiberServer) requireAdmin(c *fiber.Ctx) error {
+	if s.adminToken == "" {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "Admin endpoints are disabled",
+		})
+	}
+	if subtle.ConstantTimeCompare([]byte(c.Get(fiber.HeaderAuthorization)), []byte("Bearer "+s.adminToken)) != 1 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Invalid admin token",
+		})
+	}
+	return c.Next()
+}
+
+// HandleReloadData reloads the game data files and swaps in the new snapshot.
+// A failed load is reported with 422 and the previous data keeps being served.
+func (s *FiberServer) HandleReloadData(c *fiber.Ctx) error {
+	if s.dataReloader == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "Data reloading is not configured",
+		})
+	}
+	status, err := s.dataReloader.Reload()
+	if err != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error":  fmt.Sprintf("Reload failed: %v", err),
+			"status": status,
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(status)
+}
+
+// HandleReloadStatus reports the outcome of the most recent reload.
+func (s *FiberServer) HandleReloadStatus(c *fiber.Ctx) error {
+	if s.dataReloader == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "Data reloading is not configured",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(s.dataReloader.Status())
+}
+
+func parseItemFilter(c *fiber.Ctx) (service.ItemFilter, error) {
+	hasHandler, err := parseOptionalBool(c.Query("hasHandler"))
+	if err != nil {
+		return service.ItemFilter{}, errors.New("hasHandler must be true or false")
+	}
+	return service.ItemFilter{
+		Tag:        c.Query("tag"),
+		Component:  c.Query("component"),
+		Trait:      c.Query("trait"),
+		HasHandler: hasHandler,
+	}, nil
+}
+
+// parseOptionalBool returns nil for an empty query value.
+func parseOptionalBool(raw string) (*bool, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
 func (s *FiberServer) HelloWorldHandler(c *fiber.Ctx) error {
 	resp := fiber.Map{
 		"message": "Hello World",
@@ -1,10 +1,19 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
+
+	"tft-dps-simulator/internal/boards"
+	"tft-dps-simulator/internal/core/data"
+	"tft-dps-simulator/internal/service"
 )
 
 func TestHandler(t *testing.T) {
@@ -37,3 +46,196 @@ func TestHandler(t *testing.T) {
 		t.Errorf("expected response body to be %v; got %v", expected, string(body))
 	}
 }
+
+func TestStreamSimulationRejectsEmptyBoard(t *testing.T) {
+	app := fiber.New()
+	s := &FiberServer{App: app}
+	app.Post("/stream", s.HandleStreamSimulation)
+
+	req, err := http.NewRequest("POST", "/stream", strings.NewReader(`{"boardChampions":[]}`))
+	if err != nil {
+		t.Fatalf("error creating request. Err: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("error making request to server. Err: %v", err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status Bad Request; got %v", resp.Status)
+	}
+}
+
+func TestGetUnknownJobReturnsNotFound(t *testing.T) {
+	app := fiber.New()
+	jobManager := service.NewJobManager(nil, service.JobManagerConfig{Workers: 1, QueueSize: 1})
+	defer jobManager.Stop()
+	s := &FiberServer{App: app, jobManager: jobManager}
+	app.Get("/jobs/:id", s.HandleGetJob)
+	app.Delete("/jobs/:id", s.HandleCancelJob)
+
+	for _, method := range []string{"GET", "DELETE"} {
+		req, err := http.NewRequest(method, "/jobs/does-not-exist", nil)
+		if err != nil {
+			t.Fatalf("error creating request. Err: %v", err)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("error making request to server. Err: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("%s: expected status Not Found; got %v", method, resp.Status)
+		}
+	}
+}
+
+func TestGetUnknownBoardReturnsNotFound(t *testing.T) {
+	app := fiber.New()
+	s := &FiberServer{App: app, boardRepo: boards.NewMemoryRepository()}
+	app.Get("/boards/:code", s.HandleGetBoard)
+	app.Get("/boards/:code/result", s.HandleGetBoardResult)
+
+	for _, path := range []string{"/boards/abcdefgh", "/boards/abcdefgh/result"} {
+		req, err := http.NewRequest("GET", path, nil)
+		if err != nil {
+			t.Fatalf("error creating request. Err: %v", err)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("error making request to server. Err: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("%s: expected status Not Found; got %v", path, resp.Status)
+		}
+	}
+}
+
+func TestCreateJobUnavailable(t *testing.T) {
+	registry, err := data.FileSource{Dir: "../service/testdata/golden", SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data. Err: %v", err)
+	}
+	simService := service.NewSimulationService(registry)
+	jobManager := service.NewJobManager(simService, service.JobManagerConfig{Workers: 1, QueueSize: 1})
+	jobManager.Stop()
+	app := fiber.New()
+	s := &FiberServer{App: app, simService: simService, jobManager: jobManager}
+	app.Post("/jobs", s.HandleCreateJob)
+
+	req, err := http.NewRequest("POST", "/jobs", strings.NewReader(`{"boardChampions":[{"apiName":"TFT14_KogMaw","stars":1}]}`))
+	if err != nil {
+		t.Fatalf("error creating request. Err: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("error making request to server. Err: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status Service Unavailable from a stopped job manager; got %v", resp.Status)
+	}
+
+	for err, want := range map[error]int{
+		service.ErrJobQueueFull:      http.StatusServiceUnavailable,
+		service.ErrJobManagerStopped: http.StatusServiceUnavailable,
+		errors.New("boom"):           http.StatusInternalServerError,
+	} {
+		if got := submitJobErrorStatus(fmt.Errorf("submit: %w", err)); got != want {
+			t.Errorf("%v: expected status %d; got %d", err, want, got)
+		}
+	}
+}
+
+// failingRepository is a board repository whose storage is down.
+type failingRepository struct {
+	boards.Repository
+}
+
+func (failingRepository) Load(ctx context.Context, code string) (boards.SavedBoard, error) {
+	return boards.SavedBoard{}, errors.New("storage unavailable")
+}
+
+func TestSaveBoardReportsStorageErrors(t *testing.T) {
+	registry, err := data.FileSource{Dir: "../service/testdata/golden", SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data. Err: %v", err)
+	}
+	app := fiber.New()
+	s := &FiberServer{App: app, simService: service.NewSimulationService(registry), boardRepo: failingRepository{}}
+	app.Post("/boards", s.HandleSaveBoard)
+
+	req, err := http.NewRequest("POST", "/boards", strings.NewReader(`{"boardChampions":[{"apiName":"TFT14_KogMaw","stars":1}]}`))
+	if err != nil {
+		t.Fatalf("error creating request. Err: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("error making request to server. Err: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusInternalServerError || !strings.Contains(string(body), "storage unavailable") {
+		t.Errorf("expected the storage error; got %v %s", resp.Status, body)
+	}
+}
+
+func TestAdminReloadRequiresToken(t *testing.T) {
+	app := fiber.New()
+	s := &FiberServer{App: app}
+	s.SetDataReloader(nil, "secret")
+	app.Post("/admin/reload", s.requireAdmin, s.HandleReloadData)
+
+	cases := map[string]int{
+		"":              http.StatusUnauthorized,
+		"Bearer wrong":  http.StatusUnauthorized,
+		"Bearer secret": http.StatusServiceUnavailable, // Authorized, but no reloader configured
+	}
+	for header, expected := range cases {
+		req, err := http.NewRequest("POST", "/admin/reload", nil)
+		if err != nil {
+			t.Fatalf("error creating request. Err: %v", err)
+		}
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("error making request to server. Err: %v", err)
+		}
+		if resp.StatusCode != expected {
+			t.Errorf("%q: expected status %d; got %v", header, expected, resp.Status)
+		}
+	}
+}
+
+func TestEventSchemaListsEventTypes(t *testing.T) {
+	app := fiber.New()
+	s := &FiberServer{App: app}
+	app.Get("/events/schema", s.HandleEventSchema)
+
+	req, err := http.NewRequest("GET", "/events/schema", nil)
+	if err != nil {
+		t.Fatalf("error creating request. Err: %v", err)
+	}
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("error making request to server. Err: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status OK; got %v", resp.Status)
+	}
+	var schema struct {
+		OneOf []interface{}          `json:"oneOf"`
+		Defs  map[string]interface{} `json:"$defs"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&schema); err != nil {
+		t.Fatalf("error parsing schema. Err: %v", err)
+	}
+	if _, ok := schema.Defs["DamageAppliedEvent.v1"]; !ok {
+		t.Errorf("expected DamageAppliedEvent.v1 in $defs; got %d definitions", len(schema.Defs))
+	}
+	if len(schema.OneOf) != len(schema.Defs) {
+		t.Errorf("expected one branch per definition; got %d branches and %d definitions", len(schema.OneOf), len(schema.Defs))
+	}
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"context"
 	"log"
+	"tft-dps-simulator/internal/boards"
 	"tft-dps-simulator/internal/service" 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
@@ -10,6 +12,11 @@ import (
 type FiberServer struct {
 	*fiber.App
 	simService *service.SimulationService // Add SimulationService field
+	jobManager *service.JobManager        // Worker pool for asynchronous simulation jobs
+	boardRepo  boards.Repository          // Saved, shareable boards
+
+	dataReloader *service.DataReloader // Optional; enables the admin reload endpoint
+	adminToken   string                // Bearer token required by admin routes (empty disables them)
 }
 
 func New(simService *service.SimulationService) *FiberServer { // Accept simService
@@ -24,14 +31,30 @@ func New(simService *service.SimulationService) *FiberServer { // Accept simServ
 	server := &FiberServer{
 		App:        app,
 		simService: simService, // Store the service instance
+		jobManager: service.NewJobManager(simService, service.DefaultJobManagerConfig()),
+		boardRepo:  boards.NewMemoryRepository(),
 	}
 
 	return server
 }
 
-func (s *FiberServer) ShutdownWithContext(ctx fiber.Ctx) error {
+// SetBoardRepository replaces the default in-memory board store.
+func (s *FiberServer) SetBoardRepository(repo boards.Repository) {
+	s.boardRepo = repo
+}
+
+// SetDataReloader enables POST /api/v1/admin/data/reload, guarded by adminToken.
+func (s *FiberServer) SetDataReloader(reloader *service.DataReloader, adminToken string) {
+	s.dataReloader = reloader
+	s.adminToken = adminToken
+}
+
+func (s *FiberServer) ShutdownWithContext(ctx context.Context) error {
 	log.Println("Attempting to shutdown Fiber server...")
-	return s.App.Shutdown()
+	if s.jobManager != nil {
+		s.jobManager.Stop()
+	}
+	return s.App.ShutdownWithContext(ctx)
 }
 
 func (s *FiberServer) Listen(addr string) error {
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+)
+
+// ArchiveFormatVersion is the version of the SimulationArchive file format.
+// Bump it when the layout or the meaning of a field changes. Version 1 archives, which name events
+// without a version ("AttackLandedEvent"), are still read.
+const (
+	ArchiveFormatVersion    = 2
+	minArchiveFormatVersion = 1
+)
+
+// SimulationArchive is a recorded simulation: the request that built the world, the data it ran on,
+// every processed event with its typed payload and the results. ReplayArchive feeds it back in.
+type SimulationArchive struct {
+	FormatVersion int                        `json:"formatVersion"`
+	CreatedAt     time.Time                  `json:"createdAt"`
+	Request       RunSimulationRequest       `json:"request"`
+	DataVersion   DataVersionInfo            `json:"dataVersion"`
+	Results       []ChampionSimulationResult `json:"results"`
+	Targets       []TargetSimulationResult   `json:"targets"`
+	Events        []ArchiveEvent             `json:"events"`
+}
+
+// ArchiveEvent is one processed event. Type is the versioned event type name (see eventsys.EventTypes)
+// and Payload its fields, so the event can be decoded back into the value handlers expect.
+type ArchiveEvent struct {
+	Seq              int             `json:"seq"`
+	Timestamp        float64         `json:"timestamp"`
+	EnqueueTimestamp float64         `json:"enqueueTimestamp"`
+	Type             string          `json:"type"`
+	Payload          json.RawMessage `json:"payload"`
+}
+
+// ReplayReport compares the results of a replay with the ones recorded in the archive.
+type ReplayReport struct {
+	EventsReplayed int                        `json:"eventsReplayed"`
+	Results        []ChampionSimulationResult `json:"results"`
+	Targets        []TargetSimulationResult   `json:"targets"`
+	Mismatches     []string                   `json:"mismatches"` // Empty when the replay reproduced the recording
+}
+
+// RecordArchive runs req and returns the run as an archive. The run gets a seed if the request has none,
+// so that the archive can also be re-run from its request. Results are never served from the cache.
+func (s *SimulationService) RecordArchive(ctx context.Context, req RunSimulationRequest) (*SimulationArchive, error) {
+	if req.Seed == 0 {
+		req.Seed = time.Now().UnixNano()
+	}
+	ds, err := s.dataSetFor(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.runSimulation(ctx, ds, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &SimulationArchive{
+		FormatVersion: ArchiveFormatVersion,
+		CreatedAt:     time.Now().UTC(),
+		Request:       req,
+		DataVersion:   resp.DataVersion,
+		Results:       resp.Results,
+		Targets:       resp.Targets,
+		Events:        make([]ArchiveEvent, 0, len(resp.ArchieveEvents)),
+	}
+	for i, archived := range resp.ArchieveEvents {
+		name, payload, err := eventsys.EncodeEvent(archived.EventItem.Event)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", i, err)
+		}
+		archive.Events = append(archive.Events, ArchiveEvent{
+			Seq:              i,
+			Timestamp:        archived.EventItem.Timestamp,
+			EnqueueTimestamp: archived.EventItem.EnqueueTimestamp,
+			Type:             name,
+			Payload:          payload,
+		})
+	}
+	return archive, nil
+}
+
+// WriteArchive encodes an archive as JSON.
+func WriteArchive(w io.Writer, archive *SimulationArchive) error {
+	return json.NewEncoder(w).Encode(archive)
+}
+
+// ReadArchive decodes an archive and checks that its format version is supported.
+func ReadArchive(r io.Reader) (*SimulationArchive, error) {
+	var archive SimulationArchive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("error parsing archive: %w", err)
+	}
+	if archive.FormatVersion < minArchiveFormatVersion || archive.FormatVersion > ArchiveFormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version %d (expected %d to %d)",
+			archive.FormatVersion, minArchiveFormatVersion, ArchiveFormatVersion)
+	}
+	return &archive, nil
+}
+
+// ReplayArchive rebuilds the archived request's world on the same data and re-dispatches the archived
+// events into it in order. Differences from the recorded results point at state the events do not
+// capture, such as handlers that read the clock or iterate maps.
+func (s *SimulationService) ReplayArchive(ctx context.Context, archive *SimulationArchive) (*ReplayReport, error) {
+	req := archive.Request
+	ds, err := s.dataSetFor(req)
+	if err != nil {
+		return nil, err
+	}
+	if ds.Version != archive.DataVersion.Version {
+		return nil, fmt.Errorf("archive was recorded on data %s but %s is loaded", archive.DataVersion.Version, ds.Version)
+	}
+
+	events := make([]*eventsys.EventItem, 0, len(archive.Events))
+	for _, archived := range archive.Events {
+		evt, err := eventsys.DecodeEvent(archived.Type, archived.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", archived.Seq, err)
+		}
+		events = append(events, &eventsys.EventItem{
+			Event:            evt,
+			Timestamp:        archived.Timestamp,
+			EnqueueTimestamp: archived.EnqueueTimestamp,
+		})
+	}
+
+	prepared, err := s.prepareSimulation(ds, req)
+	if err != nil {
+		return nil, err
+	}
+	replayed := 0
+	prepared.sim.SetEventObserver(func(*eventsys.EventItem, float64) bool {
+		replayed++
+		return true
+	})
+	if err := prepared.sim.Replay(ctx, events); err != nil {
+		return nil, fmt.Errorf("replay did not complete: %w", err)
+	}
+
+	resp := prepared.collectResponse(ds, req)
+	return &ReplayReport{
+		EventsReplayed: replayed,
+		Results:        resp.Results,
+		Targets:        resp.Targets,
+		Mismatches:     compareReplay(archive, resp),
+	}, nil
+}
+
+// compareReplay lists the differences between recorded and replayed results.
+func compareReplay(archive *SimulationArchive, resp *RunSimulationResponse) []string {
+	mismatches := []string{}
+	if len(resp.Results) != len(archive.Results) || len(resp.Targets) != len(archive.Targets) {
+		return append(mismatches, fmt.Sprintf("replay produced %d champions and %d targets, archive has %d and %d",
+			len(resp.Results), len(resp.Targets), len(archive.Results), len(archive.Targets)))
+	}
+	for i, got := range resp.Results {
+		want := archive.Results[i]
+		if !sameFloat(got.DamageStats.TotalDamage, want.DamageStats.TotalDamage) {
+			mismatches = append(mismatches, fmt.Sprintf("%s (entity %d) total damage %.4f, archive %.4f",
+				got.ChampionApiName, got.ChampionEntityID, got.DamageStats.TotalDamage, want.DamageStats.TotalDamage))
+		}
+		if got.DamageStats.TotalAutoAttackCounts != want.DamageStats.TotalAutoAttackCounts ||
+			got.DamageStats.TotalSpellCastCounts != want.DamageStats.TotalSpellCastCounts {
+			mismatches = append(mismatches, fmt.Sprintf("%s (entity %d) %d attacks and %d casts, archive %d and %d",
+				got.ChampionApiName, got.ChampionEntityID, got.DamageStats.TotalAutoAttackCounts, got.DamageStats.TotalSpellCastCounts,
+				want.DamageStats.TotalAutoAttackCounts, want.DamageStats.TotalSpellCastCounts))
+		}
+	}
+	for i, got := range resp.Targets {
+		want := archive.Targets[i]
+		if !sameFloat(got.DefenseStats.TotalDamageTaken, want.DefenseStats.TotalDamageTaken) {
+			mismatches = append(mismatches, fmt.Sprintf("%s (entity %d) damage taken %.4f, archive %.4f",
+				got.ApiName, got.EntityID, got.DefenseStats.TotalDamageTaken, want.DefenseStats.TotalDamageTaken))
+		}
+	}
+	return mismatches
+}
+
+// sameFloat allows for the rounding of a JSON round trip.
+func sameFloat(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+func TestArchiveReplayReproducesRun(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorded, err := simService.RecordArchive(context.Background(), scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatalf("record failed: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := WriteArchive(&buf, recorded); err != nil {
+		t.Fatal(err)
+	}
+	archive, err := ReadArchive(&buf)
+	if err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if len(archive.Events) == 0 || archive.Events[0].Type != "ChampionActionEvent.v1" {
+		t.Fatalf("expected typed events in the archive; got %d events", len(archive.Events))
+	}
+
+	report, err := simService.ReplayArchive(context.Background(), archive)
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	if len(report.Mismatches) != 0 {
+		t.Errorf("replay did not reproduce the run: %v", report.Mismatches)
+	}
+
+	// Dropping the second half of the events must show up as a mismatch
+	archive.Events = archive.Events[:len(archive.Events)/2]
+	report, err = simService.ReplayArchive(context.Background(), archive)
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	if len(report.Mismatches) == 0 {
+		t.Error("expected mismatches after truncating the archive")
+	}
+}
+
+func TestReadArchiveRejectsUnknownVersion(t *testing.T) {
+	_, err := ReadArchive(strings.NewReader(`{"formatVersion": 99}`))
+	if err == nil || !strings.Contains(err.Error(), "format version") {
+		t.Errorf("expected format version error; got %v", err)
+	}
+}
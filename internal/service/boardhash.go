@@ -0,0 +1,90 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"tft-dps-simulator/internal/core/simulation"
+)
+
+// boardHashInput is everything that determines a simulation result.
+type boardHashInput struct {
+	DataVersion    string                      `json:"dataVersion"`
+	Config         simulation.SimulationConfig `json:"config"`
+	BoardChampions []BoardChampion             `json:"boardChampions"`
+	Enemies        []EnemyUnit                 `json:"enemies,omitempty"`
+}
+
+// NormalizeBoard returns a copy of the board with items sorted within each champion
+// and champions sorted by ApiName, stars, position and items, so equivalent boards compare equal.
+func NormalizeBoard(boardChampions []BoardChampion) []BoardChampion {
+	normalized := make([]BoardChampion, len(boardChampions))
+	for i, champ := range boardChampions {
+		items := make([]Item, len(champ.Items))
+		copy(items, champ.Items)
+		sort.Slice(items, func(a, b int) bool { return items[a].ApiName < items[b].ApiName })
+		champ.Items = items
+		normalized[i] = champ
+	}
+
+	sort.SliceStable(normalized, func(a, b int) bool {
+		ca, cb := normalized[a], normalized[b]
+		if ca.ApiName != cb.ApiName {
+			return ca.ApiName < cb.ApiName
+		}
+		if ca.Stars != cb.Stars {
+			return ca.Stars < cb.Stars
+		}
+		if ca.Position.Row != cb.Position.Row {
+			return ca.Position.Row < cb.Position.Row
+		}
+		if ca.Position.Col != cb.Position.Col {
+			return ca.Position.Col < cb.Position.Col
+		}
+		return itemKey(ca.Items) < itemKey(cb.Items)
+	})
+	return normalized
+}
+
+func itemKey(items []Item) string {
+	key := ""
+	for _, item := range items {
+		key += item.ApiName + ","
+	}
+	return key
+}
+
+// CanonicalBoardHash returns a stable hex digest of the normalized board, simulation config and data version.
+func CanonicalBoardHash(boardChampions []BoardChampion, config simulation.SimulationConfig, dataVersion string) (string, error) {
+	return canonicalRequestHash(RunSimulationRequest{BoardChampions: boardChampions}, config, dataVersion)
+}
+
+// canonicalRequestHash extends CanonicalBoardHash with the request's enemies (in order, since
+// they decide targeting).
+func canonicalRequestHash(req RunSimulationRequest, config simulation.SimulationConfig, dataVersion string) (string, error) {
+	input := boardHashInput{
+		DataVersion:    dataVersion,
+		Config:         config,
+		BoardChampions: NormalizeBoard(req.BoardChampions),
+		Enemies:        req.Enemies,
+	}
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode board for hashing: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// BoardHash returns the canonical hash of a request under this service's config and the data set
+// it selects. It matches the BoardHash reported in simulation responses.
+func (s *SimulationService) BoardHash(req RunSimulationRequest) (string, error) {
+	ds, err := s.dataSetFor(req)
+	if err != nil {
+		return "", err
+	}
+	return canonicalRequestHash(req, s.simulationConfig(req), ds.Version)
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"testing"
+
+	"tft-dps-simulator/internal/core/simulation"
+)
+
+func TestCanonicalBoardHashIgnoresOrdering(t *testing.T) {
+	config := simulation.DefaultConfig()
+	boardA := []BoardChampion{
+		{ApiName: "TFT14_Jax", Stars: 2, Items: []Item{{ApiName: "B"}, {ApiName: "A"}}},
+		{ApiName: "TFT14_Kindred", Stars: 1},
+	}
+	boardB := []BoardChampion{
+		{ApiName: "TFT14_Kindred", Stars: 1},
+		{ApiName: "TFT14_Jax", Stars: 2, Items: []Item{{ApiName: "A"}, {ApiName: "B"}}},
+	}
+
+	hashA, err := CanonicalBoardHash(boardA, config, "v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hashB, _ := CanonicalBoardHash(boardB, config, "v1")
+	if hashA != hashB {
+		t.Errorf("expected equivalent boards to hash equally; got %s and %s", hashA, hashB)
+	}
+
+	if boardA[0].Items[0].ApiName != "B" {
+		t.Errorf("expected NormalizeBoard not to mutate the request")
+	}
+
+	hashOtherVersion, _ := CanonicalBoardHash(boardA, config, "v2")
+	if hashOtherVersion == hashA {
+		t.Errorf("expected data version to change the hash")
+	}
+	hashOtherConfig, _ := CanonicalBoardHash(boardA, config.WithMaxTime(60), "v1")
+	if hashOtherConfig == hashA {
+		t.Errorf("expected config to change the hash")
+	}
+}
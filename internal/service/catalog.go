@@ -0,0 +1,139 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	"tft-dps-simulator/internal/core/data"
+	itemsys "tft-dps-simulator/internal/core/systems/items"
+	traitsys "tft-dps-simulator/internal/core/systems/traits"
+)
+
+// CatalogChampion is a champion as exposed by the catalog API.
+type CatalogChampion struct {
+	data.Champion
+}
+
+// CatalogItem is an item or augment with a flag telling whether the simulator models its passive.
+type CatalogItem struct {
+	data.Item
+	HasHandler bool `json:"hasHandler"` // Registered in itemsys.ItemRegistry
+}
+
+// CatalogTrait is a trait with a flag telling whether the simulator models its effect.
+type CatalogTrait struct {
+	data.Trait
+	HasHandler bool `json:"hasHandler"` // Registered in traitsys.TraitRegistry
+}
+
+// ChampionFilter narrows ListCatalogChampions. Zero values match everything.
+type ChampionFilter struct {
+	Cost  int    // Exact cost
+	Trait string // Trait display name or API name, case-insensitive
+}
+
+// ItemFilter narrows ListCatalogItems and ListCatalogAugments. Zero values match everything.
+type ItemFilter struct {
+	Tag        string // Tag, case-insensitive
+	Component  string // Component API name the item is built from
+	Trait      string // Associated trait API name
+	HasHandler *bool  // Only items with (or without) a handler
+}
+
+// TraitFilter narrows ListCatalogTraits. Zero values match everything.
+type TraitFilter struct {
+	HasHandler *bool
+}
+
+// ListCatalogChampions returns the champions in ds matching filter, sorted by API name.
+func ListCatalogChampions(ds *data.DataSet, filter ChampionFilter) []CatalogChampion {
+	result := []CatalogChampion{}
+	for _, champion := range ds.Champions {
+		if filter.Cost != 0 && champion.Cost != filter.Cost {
+			continue
+		}
+		if filter.Trait != "" && !championHasTrait(ds, champion, filter.Trait) {
+			continue
+		}
+		result = append(result, CatalogChampion{Champion: *champion})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ApiName < result[j].ApiName })
+	return result
+}
+
+// championHasTrait matches trait against the champion's trait names and their API names.
+func championHasTrait(ds *data.DataSet, champion *data.Champion, trait string) bool {
+	for _, name := range champion.Traits {
+		if strings.EqualFold(name, trait) {
+			return true
+		}
+		if t := ds.GetTraitByName(name); t != nil && strings.EqualFold(t.ApiName, trait) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListCatalogItems returns the active items in ds matching filter, sorted by API name.
+func ListCatalogItems(ds *data.DataSet, filter ItemFilter) []CatalogItem {
+	return listCatalogItems(ds.Items, filter)
+}
+
+// ListCatalogAugments returns the active augments in ds matching filter, sorted by API name.
+func ListCatalogAugments(ds *data.DataSet, filter ItemFilter) []CatalogItem {
+	return listCatalogItems(ds.Augments, filter)
+}
+
+func listCatalogItems(items map[string]*data.Item, filter ItemFilter) []CatalogItem {
+	result := []CatalogItem{}
+	for apiName, item := range items {
+		_, hasHandler := itemsys.GetItemHandler(apiName)
+		if filter.HasHandler != nil && hasHandler != *filter.HasHandler {
+			continue
+		}
+		if filter.Tag != "" && !containsFold(item.Tags, filter.Tag) {
+			continue
+		}
+		if filter.Component != "" && !containsFold(item.Composition, filter.Component) {
+			continue
+		}
+		if filter.Trait != "" && !containsFold(item.AssociatedTraits, filter.Trait) {
+			continue
+		}
+		result = append(result, CatalogItem{Item: *item, HasHandler: hasHandler})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ApiName < result[j].ApiName })
+	return result
+}
+
+// ListCatalogTraits returns the traits in ds matching filter, sorted by API name.
+func ListCatalogTraits(ds *data.DataSet, filter TraitFilter) []CatalogTrait {
+	result := []CatalogTrait{}
+	for _, trait := range ds.Traits {
+		hasHandler := traitHasHandler(trait)
+		if filter.HasHandler != nil && hasHandler != *filter.HasHandler {
+			continue
+		}
+		result = append(result, CatalogTrait{Trait: *trait, HasHandler: hasHandler})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ApiName < result[j].ApiName })
+	return result
+}
+
+// traitHasHandler checks both keys, since trait handlers are registered by display name.
+func traitHasHandler(trait *data.Trait) bool {
+	if _, ok := traitsys.GetTraitHandler(trait.Name); ok {
+		return true
+	}
+	_, ok := traitsys.GetTraitHandler(trait.ApiName)
+	return ok
+}
+
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+func newCatalogTestDataSet() *data.DataSet {
+	return &data.DataSet{
+		SetID: "TFTSet14",
+		Traits: map[string]*data.Trait{
+			"Rapidfire": {ApiName: "TFT14_Rapidfire", Name: "Rapidfire"},
+			"Vanguard":  {ApiName: "TFT14_Vanguard", Name: "Vanguard"},
+		},
+		Champions: map[string]*data.Champion{
+			"TFT14_Jinx":  {ApiName: "TFT14_Jinx", Cost: 4, Traits: []string{"Rapidfire"}},
+			"TFT14_Poppy": {ApiName: "TFT14_Poppy", Cost: 1, Traits: []string{"Vanguard"}},
+		},
+		Items: map[string]*data.Item{
+			data.TFT_Item_Quicksilver: {ApiName: data.TFT_Item_Quicksilver, Composition: []string{data.TFT_Item_SparringGloves, data.TFT_Item_NegatronCloak}},
+			data.TFT_Item_Deathblade:  {ApiName: data.TFT_Item_Deathblade, Composition: []string{data.TFT_Item_BFSword, data.TFT_Item_BFSword}},
+		},
+	}
+}
+
+func TestCatalogFilters(t *testing.T) {
+	ds := newCatalogTestDataSet()
+
+	if got := ListCatalogChampions(ds, ChampionFilter{Trait: "tft14_rapidfire"}); len(got) != 1 || got[0].ApiName != "TFT14_Jinx" {
+		t.Errorf("expected trait filter to match Jinx by trait API name; got %+v", got)
+	}
+	if got := ListCatalogChampions(ds, ChampionFilter{Cost: 1}); len(got) != 1 || got[0].ApiName != "TFT14_Poppy" {
+		t.Errorf("expected cost filter to match Poppy; got %+v", got)
+	}
+
+	withHandler := true
+	got := ListCatalogItems(ds, ItemFilter{HasHandler: &withHandler})
+	if len(got) != 1 || got[0].ApiName != data.TFT_Item_Quicksilver || !got[0].HasHandler {
+		t.Errorf("expected only Quicksilver to have a handler; got %+v", got)
+	}
+	if got := ListCatalogItems(ds, ItemFilter{Component: data.TFT_Item_BFSword}); len(got) != 1 || got[0].ApiName != data.TFT_Item_Deathblade {
+		t.Errorf("expected component filter to match Deathblade; got %+v", got)
+	}
+
+	traits := ListCatalogTraits(ds, TraitFilter{})
+	if len(traits) != 2 || !traits[0].HasHandler || traits[1].HasHandler {
+		t.Errorf("expected Rapidfire to have a handler and Vanguard not; got %+v", traits)
+	}
+}
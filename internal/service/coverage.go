@@ -0,0 +1,169 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"tft-dps-simulator/internal/core/data"
+	"tft-dps-simulator/internal/core/managers"
+	itemsys "tft-dps-simulator/internal/core/systems/items"
+)
+
+// CoverageStatus describes how much of an item, trait or ability the simulator models.
+type CoverageStatus string
+
+const (
+	CoverageHandler     CoverageStatus = "handler"     // Dynamic behaviour implemented by a registered handler
+	CoverageStatic      CoverageStatus = "static"      // Only stat bonuses, all of which are applied
+	CoverageGeneric     CoverageStatus = "generic"     // Approximated by shared placeholder logic
+	CoverageUnsupported CoverageStatus = "unsupported" // Passive or effect not simulated
+)
+
+// CoverageEntry is the coverage of a single item, trait or champion ability.
+type CoverageEntry struct {
+	ApiName    string         `json:"apiName"`
+	Name       string         `json:"name"`
+	Status     CoverageStatus `json:"status"`
+	Unmodelled []string       `json:"unmodelled,omitempty"` // Effect keys with no simulated behaviour
+	Note       string         `json:"note,omitempty"`
+}
+
+// CoverageReport lists what the simulator models for the loaded set.
+type CoverageReport struct {
+	Items     []CoverageEntry                   `json:"items"`
+	Traits    []CoverageEntry                   `json:"traits"`
+	Abilities []CoverageEntry                   `json:"abilities"`
+	Summary   map[string]map[CoverageStatus]int `json:"summary"` // Category -> status -> count
+	Notes     []string                          `json:"notes"`   // Caveats that apply to every entry of a category
+}
+
+// genericAbilityNote applies to every champion, so it is reported once rather than per ability or board.
+const genericAbilityNote = "abilities: modelled as AP-scaling magic damage; ability variables not simulated"
+
+// coverageNotes are the caveats that apply to every simulation.
+func coverageNotes() []string {
+	return []string{genericAbilityNote}
+}
+
+// BuildCoverageReport classifies every item, trait and champion ability in ds.
+func BuildCoverageReport(ds *data.DataSet) CoverageReport {
+	report := CoverageReport{
+		Items:     []CoverageEntry{},
+		Traits:    []CoverageEntry{},
+		Abilities: []CoverageEntry{},
+		Summary:   make(map[string]map[CoverageStatus]int),
+		Notes:     coverageNotes(),
+	}
+
+	for _, item := range ds.Items {
+		report.Items = append(report.Items, ItemCoverage(item))
+	}
+	for _, trait := range ds.Traits {
+		report.Traits = append(report.Traits, TraitCoverage(trait))
+	}
+	for _, champion := range ds.Champions {
+		report.Abilities = append(report.Abilities, AbilityCoverage(champion))
+	}
+
+	for category, entries := range map[string][]CoverageEntry{
+		"items":     report.Items,
+		"traits":    report.Traits,
+		"abilities": report.Abilities,
+	} {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].ApiName < entries[j].ApiName })
+		counts := make(map[CoverageStatus]int)
+		for _, entry := range entries {
+			counts[entry.Status]++
+		}
+		report.Summary[category] = counts
+	}
+	return report
+}
+
+// ItemCoverage classifies an item: handler, static (only applied stat bonuses) or unsupported.
+func ItemCoverage(item *data.Item) CoverageEntry {
+	entry := CoverageEntry{ApiName: item.ApiName, Name: item.Name}
+	if _, ok := itemsys.GetItemHandler(item.ApiName); ok {
+		entry.Status = CoverageHandler
+		return entry
+	}
+
+	for key, value := range item.Effects {
+		if value != 0 && !managers.IsStaticItemStat(key) {
+			entry.Unmodelled = append(entry.Unmodelled, key)
+		}
+	}
+	sort.Strings(entry.Unmodelled)
+
+	if len(entry.Unmodelled) == 0 {
+		entry.Status = CoverageStatic
+	} else {
+		entry.Status = CoverageUnsupported
+		entry.Note = "passive not simulated; only stat bonuses are applied"
+	}
+	return entry
+}
+
+// TraitCoverage classifies a trait: handler or unsupported (traits have no static fallback).
+func TraitCoverage(trait *data.Trait) CoverageEntry {
+	entry := CoverageEntry{ApiName: trait.ApiName, Name: trait.Name}
+	if traitHasHandler(trait) {
+		entry.Status = CoverageHandler
+		return entry
+	}
+	entry.Status = CoverageUnsupported
+	entry.Note = "trait effect not simulated"
+	return entry
+}
+
+// AbilityCoverage classifies a champion's ability. Abilities share the spell cast placeholder for now,
+// which the report notes once (see genericAbilityNote).
+func AbilityCoverage(champion *data.Champion) CoverageEntry {
+	return CoverageEntry{
+		ApiName: champion.ApiName,
+		Name:    champion.Ability.Name,
+		Status:  CoverageGeneric,
+	}
+}
+
+// coverageWarnings lists the parts of a board that the simulation does not model, beyond the
+// coverageNotes that apply to every board. Traits are reported when any unit carries them, regardless of whether a breakpoint is reached.
+func coverageWarnings(ds *data.DataSet, requestChampions []BoardChampion) []string {
+	seen := make(map[string]bool)
+	warnings := []string{}
+	add := func(warning string) {
+		if !seen[warning] {
+			seen[warning] = true
+			warnings = append(warnings, warning)
+		}
+	}
+
+	for _, reqChamp := range requestChampions {
+		champion := ds.GetChampionByApiName(reqChamp.ApiName)
+		if champion == nil {
+			continue
+		}
+		for _, traitName := range champion.Traits {
+			trait := ds.GetTraitByName(traitName)
+			if trait == nil {
+				continue
+			}
+			if entry := TraitCoverage(trait); entry.Status == CoverageUnsupported {
+				add(fmt.Sprintf("%s: %s", trait.ApiName, entry.Note))
+			}
+		}
+
+		for _, reqItem := range reqChamp.Items {
+			item := ds.GetItemByApiName(reqItem.ApiName)
+			if item == nil {
+				continue
+			}
+			if entry := ItemCoverage(item); entry.Status == CoverageUnsupported {
+				add(fmt.Sprintf("%s: passive not simulated", item.ApiName))
+			}
+		}
+	}
+
+	sort.Strings(warnings)
+	return warnings
+}
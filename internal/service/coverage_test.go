@@ -0,0 +1,73 @@
+package service
+
+import (
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+func TestItemCoverage(t *testing.T) {
+	tests := []struct {
+		item *data.Item
+		want CoverageStatus
+	}{
+		{&data.Item{ApiName: data.TFT_Item_Quicksilver, Effects: map[string]float64{"SpellShieldDuration": 14}}, CoverageHandler},
+		{&data.Item{ApiName: data.TFT_Item_BFSword, Effects: map[string]float64{"AD": 0.1}}, CoverageStatic},
+		{&data.Item{ApiName: "TFT_Item_Bloodthirster", Effects: map[string]float64{"AD": 0.15, "LifeSteal": 20}}, CoverageUnsupported},
+	}
+	for _, tt := range tests {
+		if got := ItemCoverage(tt.item); got.Status != tt.want {
+			t.Errorf("%s: expected %s; got %s", tt.item.ApiName, tt.want, got.Status)
+		}
+	}
+}
+
+func TestCoverageWarnings(t *testing.T) {
+	ds := &data.DataSet{
+		Traits: map[string]*data.Trait{
+			"Rapidfire": {ApiName: "TFT14_Rapidfire", Name: "Rapidfire"},
+			"Vanguard":  {ApiName: "TFT14_Vanguard", Name: "Vanguard"},
+		},
+		Champions: map[string]*data.Champion{
+			"TFT14_Jinx": {ApiName: "TFT14_Jinx", Traits: []string{"Rapidfire", "Vanguard"}},
+		},
+		Items: map[string]*data.Item{
+			"TFT_Item_Bloodthirster": {ApiName: "TFT_Item_Bloodthirster", Effects: map[string]float64{"LifeSteal": 20}},
+		},
+	}
+
+	warnings := coverageWarnings(ds, []BoardChampion{
+		{ApiName: "TFT14_Jinx", Items: []Item{{ApiName: "TFT_Item_Bloodthirster"}, {ApiName: "TFT_Item_Bloodthirster"}}},
+	})
+	want := []string{
+		"TFT14_Vanguard: trait effect not simulated",
+		"TFT_Item_Bloodthirster: passive not simulated",
+	}
+	if len(warnings) != len(want) {
+		t.Fatalf("expected %d warnings; got %v", len(want), warnings)
+	}
+	for i := range want {
+		if warnings[i] != want[i] {
+			t.Errorf("warning %d: expected %q; got %q", i, want[i], warnings[i])
+		}
+	}
+}
+
+func TestCoverageReportNotesGenericAbilitiesOnce(t *testing.T) {
+	ds := &data.DataSet{
+		Champions: map[string]*data.Champion{
+			"TFT14_Jinx":   {ApiName: "TFT14_Jinx"},
+			"TFT14_KogMaw": {ApiName: "TFT14_KogMaw"},
+		},
+	}
+
+	report := BuildCoverageReport(ds)
+	if len(report.Notes) != 1 || report.Notes[0] != genericAbilityNote {
+		t.Errorf("expected the generic ability note once; got %v", report.Notes)
+	}
+	for _, entry := range report.Abilities {
+		if entry.Status != CoverageGeneric || entry.Note != "" {
+			t.Errorf("%s: expected a generic ability without its own note; got %+v", entry.ApiName, entry)
+		}
+	}
+}
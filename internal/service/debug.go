@@ -0,0 +1,67 @@
+package service
+
+import (
+	"tft-dps-simulator/internal/core/data"
+	"tft-dps-simulator/internal/core/entity"
+	"tft-dps-simulator/internal/core/simulation"
+)
+
+// DebugSession is a request's simulation prepared for stepping through with a simulation.Debugger
+// instead of being run to the end (see the tftsim -debug REPL).
+type DebugSession struct {
+	Debugger *simulation.Debugger
+	ds       *data.DataSet
+	req      RunSimulationRequest
+	prepared *preparedSimulation
+}
+
+// NewDebugSession builds the world for req and returns it paused before the first event.
+func (s *SimulationService) NewDebugSession(req RunSimulationRequest) (*DebugSession, error) {
+	ds, err := s.dataSetFor(req)
+	if err != nil {
+		return nil, err
+	}
+	prepared, err := s.prepareSimulation(ds, req)
+	if err != nil {
+		return nil, err
+	}
+	debugger, err := simulation.NewDebugger(prepared.sim)
+	if err != nil {
+		return nil, err
+	}
+	return &DebugSession{Debugger: debugger, ds: ds, req: req, prepared: prepared}, nil
+}
+
+// Fork copies the session at its current event (see simulation.Debugger.Fork), for continuing the
+// fight down another path. Changes to the fork's world do not affect this session.
+func (d *DebugSession) Fork() (*DebugSession, error) {
+	debugger, err := d.Debugger.Fork()
+	if err != nil {
+		return nil, err
+	}
+	prepared := *d.prepared
+	prepared.world = debugger.World()
+	prepared.sim = debugger.Simulation()
+	return &DebugSession{Debugger: debugger, ds: d.ds, req: d.req, prepared: &prepared}, nil
+}
+
+// EntityName returns the ApiName of a champion or target dummy, or "" for other entities.
+func (d *DebugSession) EntityName(e entity.Entity) string {
+	return d.prepared.entityMap[e]
+}
+
+// Champions returns the board champions in request order.
+func (d *DebugSession) Champions() []entity.Entity {
+	return d.prepared.champions
+}
+
+// Enemies returns the target dummies in request order.
+func (d *DebugSession) Enemies() []entity.Entity {
+	return d.prepared.enemies
+}
+
+// Response collects results from the world as it is now. Once the debugger has finished they
+// match what RunSimulation returns for the same seeded request.
+func (d *DebugSession) Response() *RunSimulationResponse {
+	return d.prepared.collectResponse(d.ds, d.req)
+}
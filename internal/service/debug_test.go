@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+	"tft-dps-simulator/internal/core/simulation"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+)
+
+func TestDebugSessionMatchesRun(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := simService.RunSimulationWithContext(context.Background(), scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatalf("simulation failed: %v", err)
+	}
+
+	session, err := simService.NewDebugSession(scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	debugger := session.Debugger
+	champion := session.Champions()[1]
+
+	if item := debugger.Step(); item == nil || debugger.EventsProcessed() != 1 {
+		t.Fatalf("expected one event after Step; got %d", debugger.EventsProcessed())
+	}
+	if stop := debugger.StepN(5); stop.Reason != simulation.StopStep || stop.Processed != 5 {
+		t.Errorf("StepN(5) = %+v", stop)
+	}
+	if stop := debugger.RunUntil(2.0); stop.Reason != simulation.StopTime || debugger.CurrentTime() > 2.0 || debugger.Next().Timestamp <= 2.0 {
+		t.Errorf("RunUntil(2) = %+v at %.3fs", stop, debugger.CurrentTime())
+	}
+
+	if _, err := debugger.AddBreakpoint("NotAnEvent", 0); err == nil {
+		t.Error("expected an error for an unknown event type")
+	}
+	bp, err := debugger.AddBreakpoint("AttackLandedEvent", champion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := debugger.Continue()
+	if stop.Reason != simulation.StopBreakpoint || stop.Breakpoint.ID != bp.ID {
+		t.Fatalf("expected to stop at breakpoint %d; got %+v", bp.ID, stop)
+	}
+	landed, ok := debugger.Next().Event.(eventsys.AttackLandedEvent)
+	if !ok || landed.Source != champion {
+		t.Errorf("expected the next event to be champion %d's AttackLandedEvent; got %#v", champion, debugger.Next().Event)
+	}
+	if _, ok := debugger.World().Components(champion)["Attack"]; !ok {
+		t.Error("expected the champion's Attack component to be inspectable")
+	}
+	if len(debugger.Pending()) == 0 || debugger.Pending()[0] != debugger.Next() {
+		t.Error("expected the pending queue to start with the next event")
+	}
+
+	debugger.RemoveBreakpoint(bp.ID)
+	if stop := debugger.Continue(); stop.Reason != simulation.StopFinished || !debugger.Finished() {
+		t.Fatalf("expected the simulation to finish; got %+v", stop)
+	}
+	got := session.Response()
+	for i, result := range got.Results {
+		if !sameFloat(result.DamageStats.TotalDamage, want.Results[i].DamageStats.TotalDamage) {
+			t.Errorf("%s: stepped total damage %.4f, run %.4f", result.ChampionApiName,
+				result.DamageStats.TotalDamage, want.Results[i].DamageStats.TotalDamage)
+		}
+	}
+}
+
+func TestForkedSessionsContinueIndependently(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := simService.RunSimulationWithContext(context.Background(), scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatalf("simulation failed: %v", err)
+	}
+
+	session, err := simService.NewDebugSession(scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session.Debugger.RunUntil(10.0)
+	unchanged, err := session.Fork()
+	if err != nil {
+		t.Fatal(err)
+	}
+	armored, err := session.Fork()
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot, err := session.Debugger.Simulation().Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	branch := snapshot.Branch()
+	if snapshot.Time() != session.Debugger.CurrentTime() || branch.World() == session.Debugger.World() {
+		t.Errorf("expected a snapshot at %.3fs with its own world; got %.3fs", session.Debugger.CurrentTime(), snapshot.Time())
+	}
+	if err := branch.RunSimulationWithContext(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	branchStats, _ := branch.World().GetDamageStats(session.Champions()[0])
+
+	dummy := armored.Enemies()[0]
+	health, _ := armored.Debugger.World().GetHealth(dummy)
+	health.SetBaseArmor(500)
+	health.SetFinalArmor(500)
+
+	for _, s := range []*DebugSession{session, unchanged, armored} {
+		if stop := s.Debugger.Continue(); stop.Reason != simulation.StopFinished {
+			t.Fatalf("expected the simulation to finish; got %+v", stop)
+		}
+	}
+	if !sameFloat(branchStats.TotalDamage, want.Results[0].DamageStats.TotalDamage) {
+		t.Errorf("snapshot branch dealt %.4f, full run %.4f", branchStats.TotalDamage, want.Results[0].DamageStats.TotalDamage)
+	}
+	for i, result := range want.Results {
+		original := session.Response().Results[i].DamageStats.TotalDamage
+		branch := unchanged.Response().Results[i].DamageStats.TotalDamage
+		withArmor := armored.Response().Results[i].DamageStats.TotalDamage
+		if !sameFloat(original, result.DamageStats.TotalDamage) || !sameFloat(branch, result.DamageStats.TotalDamage) {
+			t.Errorf("%s: original %.4f and unchanged fork %.4f should match the full run %.4f",
+				result.ChampionApiName, original, branch, result.DamageStats.TotalDamage)
+		}
+		if withArmor >= branch {
+			t.Errorf("%s: armored fork dealt %.4f, expected less than %.4f", result.ChampionApiName, withArmor, branch)
+		}
+	}
+}
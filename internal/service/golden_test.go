@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+// Regenerate the goldens after an intended balance change with:
+//
+//	go test ./internal/service -run TestGoldenScenarios -update
+var update = flag.Bool("update", false, "rewrite testdata/golden/expected from the current simulation")
+
+const (
+	goldenDir          = "testdata/golden"
+	goldenRelTolerance = 1e-6 // Relative difference allowed in damage numbers
+	goldenAbsTolerance = 1e-6 // Absolute difference allowed near zero
+)
+
+// goldenResult is the part of a response pinned by the golden files.
+type goldenResult struct {
+	Scenario    string           `json:"scenario"`
+	DataVersion string           `json:"dataVersion"`
+	Champions   []goldenChampion `json:"champions"`
+	Targets     []goldenTarget   `json:"targets"`
+}
+
+type goldenChampion struct {
+	ApiName     string  `json:"apiName"`
+	TotalDamage float64 `json:"totalDamage"`
+	DPS         float64 `json:"dps"`
+	ADDamage    float64 `json:"adDamage"`
+	APDamage    float64 `json:"apDamage"`
+	TrueDamage  float64 `json:"trueDamage"`
+	AutoAttacks int     `json:"autoAttacks"`
+	SpellCasts  int     `json:"spellCasts"`
+}
+
+type goldenTarget struct {
+	ApiName     string  `json:"apiName"`
+	DamageTaken float64 `json:"damageTaken"`
+}
+
+func TestGoldenScenarios(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	registry, err := data.FileSource{
+		Dir:          goldenDir,
+		SetIDs:       []string{"TFTSet14"},
+		DefaultSet:   "TFTSet14",
+		DefaultPatch: "golden",
+	}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+
+	files, err := filepath.Glob(filepath.Join(goldenDir, "scenarios", "*.json"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no golden scenarios found (%v)", err)
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+			scenario, err := LoadScenarioFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := runGolden(t, simService, scenario)
+			if again := runGolden(t, simService, scenario); compareGolden(again, got) != nil {
+				t.Fatalf("repeated runs gave different results: %v", compareGolden(again, got))
+			}
+
+			expectedFile := filepath.Join(goldenDir, "expected", name+".json")
+			if *update {
+				writeGolden(t, expectedFile, got)
+				return
+			}
+
+			encoded, err := os.ReadFile(expectedFile)
+			if err != nil {
+				t.Fatalf("missing golden file (run with -update to create it): %v", err)
+			}
+			var want goldenResult
+			if err := json.Unmarshal(encoded, &want); err != nil {
+				t.Fatalf("error parsing %s: %v", expectedFile, err)
+			}
+			for _, diff := range compareGolden(got, want) {
+				t.Error(diff)
+			}
+			if t.Failed() {
+				t.Log("if this change is intended, run with -update and commit the new goldens")
+			}
+		})
+	}
+}
+
+// runGolden runs a scenario and keeps the pinned numbers, with champions in board order.
+func runGolden(t *testing.T, simService *SimulationService, scenario *Scenario) goldenResult {
+	t.Helper()
+	if _, err := simService.CheckRequest(scenario.RunSimulationRequest); err != nil {
+		t.Fatalf("invalid scenario: %v", err)
+	}
+	resp, err := simService.RunSimulationWithContext(context.Background(), scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatalf("simulation failed: %v", err)
+	}
+
+	sort.Slice(resp.Results, func(i, j int) bool {
+		return resp.Results[i].ChampionEntityID < resp.Results[j].ChampionEntityID
+	})
+	result := goldenResult{
+		Scenario:    scenario.Name,
+		DataVersion: resp.DataVersion.Version,
+		Champions:   []goldenChampion{},
+		Targets:     []goldenTarget{},
+	}
+	for _, champ := range resp.Results {
+		stats := champ.DamageStats
+		result.Champions = append(result.Champions, goldenChampion{
+			ApiName:     champ.ChampionApiName,
+			TotalDamage: stats.TotalDamage,
+			DPS:         stats.DamagePerSecond,
+			ADDamage:    stats.TotalADDamage,
+			APDamage:    stats.TotalAPDamage,
+			TrueDamage:  stats.TotalTrueDamage,
+			AutoAttacks: stats.TotalAutoAttackCounts,
+			SpellCasts:  stats.TotalSpellCastCounts,
+		})
+	}
+	for _, target := range resp.Targets {
+		result.Targets = append(result.Targets, goldenTarget{
+			ApiName:     target.ApiName,
+			DamageTaken: target.DefenseStats.TotalDamageTaken,
+		})
+	}
+	return result
+}
+
+// compareGolden lists every difference between got and want beyond the tolerances.
+func compareGolden(got, want goldenResult) []string {
+	var diffs []string
+	if got.DataVersion != want.DataVersion {
+		diffs = append(diffs, fmt.Sprintf("data version = %s, golden %s", got.DataVersion, want.DataVersion))
+	}
+	if len(got.Champions) != len(want.Champions) || len(got.Targets) != len(want.Targets) {
+		return append(diffs, fmt.Sprintf("%d champions and %d targets, golden has %d and %d",
+			len(got.Champions), len(got.Targets), len(want.Champions), len(want.Targets)))
+	}
+
+	floatDiff := func(label string, got, want float64) {
+		if math.Abs(got-want) > goldenAbsTolerance+goldenRelTolerance*math.Abs(want) {
+			diffs = append(diffs, fmt.Sprintf("%s = %.6f, golden %.6f (%+.2f%%)", label, got, want, percentChange(got, want)))
+		}
+	}
+	intDiff := func(label string, got, want int) {
+		if got != want {
+			diffs = append(diffs, fmt.Sprintf("%s = %d, golden %d", label, got, want))
+		}
+	}
+	for i, g := range got.Champions {
+		w := want.Champions[i]
+		if g.ApiName != w.ApiName {
+			diffs = append(diffs, fmt.Sprintf("champion %d is %s, golden %s", i, g.ApiName, w.ApiName))
+			continue
+		}
+		floatDiff(g.ApiName+" totalDamage", g.TotalDamage, w.TotalDamage)
+		floatDiff(g.ApiName+" dps", g.DPS, w.DPS)
+		floatDiff(g.ApiName+" adDamage", g.ADDamage, w.ADDamage)
+		floatDiff(g.ApiName+" apDamage", g.APDamage, w.APDamage)
+		floatDiff(g.ApiName+" trueDamage", g.TrueDamage, w.TrueDamage)
+		intDiff(g.ApiName+" autoAttacks", g.AutoAttacks, w.AutoAttacks)
+		intDiff(g.ApiName+" spellCasts", g.SpellCasts, w.SpellCasts)
+	}
+	for i, g := range got.Targets {
+		floatDiff(fmt.Sprintf("target %d (%s) damageTaken", i, g.ApiName), g.DamageTaken, want.Targets[i].DamageTaken)
+	}
+	return diffs
+}
+
+func percentChange(got, want float64) float64 {
+	if want == 0 {
+		return math.Inf(1)
+	}
+	return (got - want) / want * 100
+}
+
+func writeGolden(t *testing.T, path string, result goldenResult) {
+	t.Helper()
+	encoded, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, append(encoded, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("updated %s", path)
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// JobStatus is the lifecycle state of an asynchronous simulation job.
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobCompleted JobStatus = "completed"
+	JobFailed    JobStatus = "failed"
+	JobCancelled JobStatus = "cancelled"
+)
+
+var (
+	// ErrJobQueueFull is returned by Submit when every worker is busy and the queue is at capacity.
+	ErrJobQueueFull = errors.New("job queue is full")
+	// ErrJobNotFound is returned when no job exists for the given ID.
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobManagerStopped is returned by Submit after Stop has been called.
+	ErrJobManagerStopped = errors.New("job manager is stopped")
+)
+
+// Job is a snapshot of an asynchronous simulation job.
+type Job struct {
+	ID         string                 `json:"id"`
+	Status     JobStatus              `json:"status"`
+	CreatedAt  time.Time              `json:"createdAt"`
+	StartedAt  *time.Time             `json:"startedAt,omitempty"`
+	FinishedAt *time.Time             `json:"finishedAt,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	Result     *RunSimulationResponse `json:"result,omitempty"`
+}
+
+// JobManagerConfig controls the worker pool backing the job API.
+type JobManagerConfig struct {
+	Workers    int           // Number of simulations run concurrently
+	QueueSize  int           // Jobs that may wait for a worker before Submit fails
+	JobTimeout time.Duration // Per-job time limit (0 for none)
+	Retention  time.Duration // How long finished jobs are kept for polling (0 to keep forever)
+}
+
+// DefaultJobManagerConfig returns a config sized to the machine.
+func DefaultJobManagerConfig() JobManagerConfig {
+	return JobManagerConfig{
+		Workers:    runtime.NumCPU(),
+		QueueSize:  64,
+		JobTimeout: 2 * time.Minute,
+		Retention:  30 * time.Minute,
+	}
+}
+
+// jobEntry is the manager's internal record of a job.
+type jobEntry struct {
+	job    Job
+	req    RunSimulationRequest
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// JobManager runs simulation requests on a bounded worker pool.
+type JobManager struct {
+	simService *SimulationService
+	simulate   func(ctx context.Context, req RunSimulationRequest) (*RunSimulationResponse, error)
+	config     JobManagerConfig
+
+	mu      sync.RWMutex
+	jobs    map[string]*jobEntry
+	queue   chan *jobEntry
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// NewJobManager creates a JobManager and starts its workers.
+func NewJobManager(simService *SimulationService, config JobManagerConfig) *JobManager {
+	return newJobManager(simService, simService.RunSimulationWithContext, config)
+}
+
+// newJobManager creates a JobManager whose jobs run simulate, so tests can control how long a run takes.
+func newJobManager(simService *SimulationService, simulate func(context.Context, RunSimulationRequest) (*RunSimulationResponse, error), config JobManagerConfig) *JobManager {
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+	if config.QueueSize < 0 {
+		config.QueueSize = 0
+	}
+
+	jm := &JobManager{
+		simService: simService,
+		simulate:   simulate,
+		config:     config,
+		jobs:       make(map[string]*jobEntry),
+		queue:      make(chan *jobEntry, config.QueueSize),
+	}
+
+	for i := 0; i < config.Workers; i++ {
+		jm.wg.Add(1)
+		go jm.worker(i)
+	}
+	log.Printf("JobManager: Started %d workers (queue size %d, timeout %s)", config.Workers, config.QueueSize, config.JobTimeout)
+	return jm
+}
+
+// Submit queues a simulation request and returns the queued job.
+func (jm *JobManager) Submit(req RunSimulationRequest) (Job, error) {
+	id, err := newJobID()
+	if err != nil {
+		return Job{}, fmt.Errorf("failed to generate job id: %w", err)
+	}
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if jm.config.JobTimeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), jm.config.JobTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	entry := &jobEntry{
+		job: Job{
+			ID:        id,
+			Status:    JobQueued,
+			CreatedAt: time.Now(),
+		},
+		req:    req,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	if jm.stopped {
+		cancel()
+		return Job{}, ErrJobManagerStopped
+	}
+	jm.pruneLocked()
+
+	select {
+	case jm.queue <- entry:
+		jm.jobs[id] = entry
+		log.Printf("JobManager: Queued job %s (%d champions)", id, len(req.BoardChampions))
+		return entry.job, nil
+	default:
+		cancel()
+		return Job{}, ErrJobQueueFull
+	}
+}
+
+// Get returns a snapshot of the job with the given ID.
+func (jm *JobManager) Get(id string) (Job, error) {
+	jm.mu.RLock()
+	defer jm.mu.RUnlock()
+	entry, ok := jm.jobs[id]
+	if !ok {
+		return Job{}, ErrJobNotFound
+	}
+	return entry.job, nil
+}
+
+// Cancel stops a queued or running job. Cancelling a finished job is a no-op.
+func (jm *JobManager) Cancel(id string) (Job, error) {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	entry, ok := jm.jobs[id]
+	if !ok {
+		return Job{}, ErrJobNotFound
+	}
+
+	switch entry.job.Status {
+	case JobQueued:
+		// The worker will see the cancelled context and skip it.
+		entry.cancel()
+		jm.finishLocked(entry, JobCancelled, nil, nil)
+	case JobRunning:
+		// The event loop stops at its next iteration; the worker records the final status.
+		entry.cancel()
+	}
+	return entry.job, nil
+}
+
+// Stop cancels all outstanding jobs and waits for the workers to exit.
+func (jm *JobManager) Stop() {
+	jm.mu.Lock()
+	if jm.stopped {
+		jm.mu.Unlock()
+		return
+	}
+	jm.stopped = true
+	for _, entry := range jm.jobs {
+		entry.cancel()
+	}
+	close(jm.queue)
+	jm.mu.Unlock()
+
+	jm.wg.Wait()
+	log.Println("JobManager: All workers stopped")
+}
+
+func (jm *JobManager) worker(workerID int) {
+	defer jm.wg.Done()
+	for entry := range jm.queue {
+		jm.run(workerID, entry)
+	}
+}
+
+func (jm *JobManager) run(workerID int, entry *jobEntry) {
+	jm.mu.Lock()
+	if entry.job.Status != JobQueued || entry.ctx.Err() != nil {
+		if entry.job.Status == JobQueued {
+			jm.finishLocked(entry, statusForError(entry.ctx.Err()), nil, entry.ctx.Err())
+		}
+		jm.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	entry.job.Status = JobRunning
+	entry.job.StartedAt = &now
+	jm.mu.Unlock()
+
+	// Warnings are worked out on the data the run is about to use, outside the manager lock.
+	var warnings []string
+	if jm.simService != nil {
+		warnings, _ = jm.simService.CheckRequest(entry.req)
+	}
+
+	log.Printf("JobManager: Worker %d running job %s", workerID, entry.job.ID)
+	resp, err := jm.simulate(entry.ctx, entry.req)
+	if err == nil && len(warnings) > 0 {
+		resp.Warnings = append(warnings, resp.Warnings...)
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	if err == nil {
+		jm.finishLocked(entry, JobCompleted, resp, nil)
+	} else {
+		jm.finishLocked(entry, statusForError(err), nil, err)
+	}
+	entry.cancel()
+	log.Printf("JobManager: Job %s finished with status %s", entry.job.ID, entry.job.Status)
+}
+
+// statusForError maps the error a job stopped with to its final status: cancelled when it was
+// cancelled, failed otherwise (including context.DeadlineExceeded, the per-job timeout).
+func statusForError(err error) JobStatus {
+	if errors.Is(err, context.Canceled) {
+		return JobCancelled
+	}
+	return JobFailed
+}
+
+// finishLocked records a terminal state. Callers must hold jm.mu.
+func (jm *JobManager) finishLocked(entry *jobEntry, status JobStatus, resp *RunSimulationResponse, err error) {
+	now := time.Now()
+	entry.job.Status = status
+	entry.job.FinishedAt = &now
+	entry.job.Result = resp
+	if err != nil {
+		entry.job.Error = err.Error()
+	}
+}
+
+// pruneLocked drops finished jobs older than the retention period. Callers must hold jm.mu.
+func (jm *JobManager) pruneLocked() {
+	if jm.config.Retention <= 0 {
+		return
+	}
+	cutoff := time.Now().Add(-jm.config.Retention)
+	for id, entry := range jm.jobs {
+		if entry.job.FinishedAt != nil && entry.job.FinishedAt.Before(cutoff) {
+			delete(jm.jobs, id)
+		}
+	}
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+// blockingSimulation stands in for a simulation that runs until released or until its context ends.
+type blockingSimulation struct {
+	started chan RunSimulationRequest
+	release chan struct{}
+}
+
+func newBlockingSimulation() *blockingSimulation {
+	return &blockingSimulation{started: make(chan RunSimulationRequest, 10), release: make(chan struct{})}
+}
+
+func (b *blockingSimulation) run(ctx context.Context, req RunSimulationRequest) (*RunSimulationResponse, error) {
+	b.started <- req
+	select {
+	case <-b.release:
+		return &RunSimulationResponse{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// waitForStatus polls the job until it reaches status, failing the test after a second.
+func waitForStatus(t *testing.T, jm *JobManager, id string, status JobStatus) Job {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		job, err := jm.Get(id)
+		if err != nil {
+			t.Fatalf("job %s: %v", id, err)
+		}
+		if job.Status == status {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s: expected status %s; still %s", id, status, job.Status)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func submitJob(t *testing.T, jm *JobManager) Job {
+	t.Helper()
+	job, err := jm.Submit(RunSimulationRequest{BoardChampions: []BoardChampion{{ApiName: "TFT14_KogMaw", Stars: 1}}})
+	if err != nil {
+		t.Fatalf("unexpected submit error: %v", err)
+	}
+	if job.Status != JobQueued {
+		t.Fatalf("expected a submitted job to be queued; got %s", job.Status)
+	}
+	return job
+}
+
+func quietLogs(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+}
+
+func TestJobCompletesWithSimulationResult(t *testing.T) {
+	quietLogs(t)
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	jm := NewJobManager(NewSimulationService(registry), JobManagerConfig{Workers: 2, QueueSize: 4, JobTimeout: 10 * time.Second})
+	defer jm.Stop()
+
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "kogmaw_solo.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	job, err := jm.Submit(scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	job = waitForStatus(t, jm, job.ID, JobCompleted)
+	if job.Result == nil || len(job.Result.Results) != 1 || job.Result.Results[0].DamageStats.TotalDamage <= 0 {
+		t.Errorf("expected the simulation result on the completed job; got %+v", job.Result)
+	}
+	if job.StartedAt == nil || job.FinishedAt == nil || job.Error != "" {
+		t.Errorf("expected start and finish times and no error; got %+v", job)
+	}
+}
+
+func TestCancelQueuedJob(t *testing.T) {
+	quietLogs(t)
+	sim := newBlockingSimulation()
+	jm := newJobManager(nil, sim.run, JobManagerConfig{Workers: 1, QueueSize: 2})
+	defer jm.Stop()
+
+	running := submitJob(t, jm)
+	<-sim.started
+	queued := submitJob(t, jm)
+
+	job, err := jm.Cancel(queued.ID)
+	if err != nil || job.Status != JobCancelled {
+		t.Fatalf("expected the queued job to be cancelled at once; got %s (%v)", job.Status, err)
+	}
+
+	close(sim.release)
+	waitForStatus(t, jm, running.ID, JobCompleted)
+	select {
+	case <-sim.started:
+		t.Error("expected the cancelled job never to run")
+	case <-time.After(20 * time.Millisecond):
+	}
+	if job, _ := jm.Get(queued.ID); job.Status != JobCancelled {
+		t.Errorf("expected the cancelled job to stay cancelled; got %s", job.Status)
+	}
+}
+
+func TestCancelRunningJob(t *testing.T) {
+	quietLogs(t)
+	sim := newBlockingSimulation()
+	jm := newJobManager(nil, sim.run, JobManagerConfig{Workers: 1, QueueSize: 1})
+	defer jm.Stop()
+
+	job := submitJob(t, jm)
+	<-sim.started
+	waitForStatus(t, jm, job.ID, JobRunning)
+
+	if _, err := jm.Cancel(job.ID); err != nil {
+		t.Fatal(err)
+	}
+	job = waitForStatus(t, jm, job.ID, JobCancelled)
+	if job.Error == "" || job.Result != nil {
+		t.Errorf("expected a cancellation error and no result; got %+v", job)
+	}
+
+	// Cancelling a finished job changes nothing
+	if again, err := jm.Cancel(job.ID); err != nil || again.Status != JobCancelled {
+		t.Errorf("expected cancelling a finished job to be a no-op; got %s (%v)", again.Status, err)
+	}
+}
+
+func TestCancelledContextStopsSimulation(t *testing.T) {
+	quietLogs(t)
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "kogmaw_solo.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A running job is cancelled through its context; the event loop must check it.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = NewSimulationService(registry).RunSimulationWithContext(ctx, scenario.RunSimulationRequest)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled; got %v", err)
+	}
+}
+
+func TestJobTimeoutFailsJob(t *testing.T) {
+	quietLogs(t)
+	sim := newBlockingSimulation()
+	jm := newJobManager(nil, sim.run, JobManagerConfig{Workers: 1, QueueSize: 1, JobTimeout: 20 * time.Millisecond})
+	defer jm.Stop()
+
+	running := submitJob(t, jm)
+	<-sim.started
+	queued := submitJob(t, jm) // Times out while waiting for the worker
+
+	job := waitForStatus(t, jm, running.ID, JobFailed)
+	if job.Error != context.DeadlineExceeded.Error() {
+		t.Errorf("expected the deadline error; got %q", job.Error)
+	}
+	job = waitForStatus(t, jm, queued.ID, JobFailed)
+	if job.Error != context.DeadlineExceeded.Error() {
+		t.Errorf("expected the queued job to fail with the deadline error; got %q", job.Error)
+	}
+}
+
+func TestSubmitFailsWhenQueueIsFull(t *testing.T) {
+	quietLogs(t)
+	sim := newBlockingSimulation()
+	jm := newJobManager(nil, sim.run, JobManagerConfig{Workers: 1, QueueSize: 1})
+	defer jm.Stop()
+
+	submitJob(t, jm)
+	<-sim.started
+	submitJob(t, jm)
+
+	if _, err := jm.Submit(RunSimulationRequest{}); !errors.Is(err, ErrJobQueueFull) {
+		t.Errorf("expected ErrJobQueueFull; got %v", err)
+	}
+}
+
+func TestStopCancelsJobsAndWaitsForWorkers(t *testing.T) {
+	quietLogs(t)
+	sim := newBlockingSimulation()
+	jm := newJobManager(nil, sim.run, JobManagerConfig{Workers: 1, QueueSize: 2})
+
+	running := submitJob(t, jm)
+	<-sim.started
+	queued := submitJob(t, jm)
+
+	stopped := make(chan struct{})
+	go func() {
+		jm.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected Stop to return once the workers exit")
+	}
+
+	// The workers are gone, so the statuses are final
+	for _, id := range []string{running.ID, queued.ID} {
+		if job, _ := jm.Get(id); job.Status != JobCancelled {
+			t.Errorf("job %s: expected cancelled after Stop; got %s", id, job.Status)
+		}
+	}
+	if _, err := jm.Submit(RunSimulationRequest{}); !errors.Is(err, ErrJobManagerStopped) {
+		t.Errorf("expected ErrJobManagerStopped; got %v", err)
+	}
+	jm.Stop() // Stopping twice is a no-op
+}
+
+func TestFinishedJobsArePrunedAfterRetention(t *testing.T) {
+	quietLogs(t)
+	sim := newBlockingSimulation()
+	close(sim.release) // Every run completes at once
+	jm := newJobManager(nil, sim.run, JobManagerConfig{Workers: 1, QueueSize: 2, Retention: 10 * time.Millisecond})
+	defer jm.Stop()
+
+	old := submitJob(t, jm)
+	waitForStatus(t, jm, old.ID, JobCompleted)
+	time.Sleep(20 * time.Millisecond)
+
+	recent := submitJob(t, jm) // Submitting prunes expired jobs
+	if _, err := jm.Get(old.ID); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("expected the expired job to be pruned; got %v", err)
+	}
+	if _, err := jm.Get(recent.ID); err != nil {
+		t.Errorf("expected the new job to be kept; got %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+// DataSource builds game data snapshots for a DataReloader (see data.FileSource).
+type DataSource interface {
+	// Load builds a complete, validated registry or fails without side effects.
+	Load() (*data.DataRegistry, error)
+	// Fingerprint changes whenever the underlying files do.
+	Fingerprint() (string, error)
+}
+
+// ReloadStatus reports the outcome of the most recent data reload.
+type ReloadStatus struct {
+	LastAttempt time.Time          `json:"lastAttempt,omitempty"`
+	LastSuccess time.Time          `json:"lastSuccess,omitempty"`
+	Error       string             `json:"error,omitempty"` // Why the last attempt failed; the previous data is still served
+	DataSets    []data.DataSetInfo `json:"dataSets"`
+}
+
+// DataReloader rebuilds the game data from a DataSource and swaps it into a SimulationService.
+// A failed load leaves the running snapshot in place.
+type DataReloader struct {
+	source     DataSource
+	simService *SimulationService
+
+	mu          sync.Mutex // Serializes reloads
+	status      ReloadStatus
+	fingerprint string // Fingerprint of the files behind the last attempt
+}
+
+// NewDataReloader creates a DataReloader for data simService already serves from source.
+func NewDataReloader(simService *SimulationService, source DataSource) *DataReloader {
+	fingerprint, err := source.Fingerprint()
+	if err != nil {
+		log.Printf("DataReloader: Failed to fingerprint data files: %v", err)
+	}
+	return &DataReloader{
+		source:      source,
+		simService:  simService,
+		fingerprint: fingerprint,
+		status: ReloadStatus{
+			LastSuccess: time.Now(),
+			DataSets:    simService.DataSets(),
+		},
+	}
+}
+
+// Reload loads a fresh snapshot and swaps it in if it is valid.
+// The returned status is also available from Status until the next attempt.
+func (r *DataReloader) Reload() (ReloadStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if fingerprint, err := r.source.Fingerprint(); err == nil {
+		r.fingerprint = fingerprint
+	}
+	r.status.LastAttempt = time.Now()
+
+	registry, err := r.source.Load()
+	if err != nil {
+		log.Printf("DataReloader: Reload failed, keeping current data: %v", err)
+		r.status.Error = err.Error()
+		return r.status, err
+	}
+
+	r.simService.SetDataRegistry(registry)
+	r.status.LastSuccess = r.status.LastAttempt
+	r.status.Error = ""
+	r.status.DataSets = registry.List()
+	log.Printf("DataReloader: Swapped in %d data sets (default %s)", len(r.status.DataSets), registry.Default().Version)
+	return r.status, nil
+}
+
+// Status returns the outcome of the most recent reload.
+func (r *DataReloader) Status() ReloadStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// Watch polls the source every interval and reloads once a change has stayed put for a full
+// interval, so files still being written are not picked up half way. It returns when ctx is done.
+func (r *DataReloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	previous := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		fingerprint, err := r.source.Fingerprint()
+		if err != nil {
+			log.Printf("DataReloader: Failed to fingerprint data files: %v", err)
+			continue
+		}
+		r.mu.Lock()
+		changed := fingerprint != r.fingerprint
+		r.mu.Unlock()
+
+		if changed && fingerprint == previous {
+			log.Println("DataReloader: Data files changed, reloading")
+			r.Reload()
+		}
+		previous = fingerprint
+	}
+}
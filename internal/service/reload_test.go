@@ -0,0 +1,64 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+// fakeSource hands out a prepared registry or error.
+type fakeSource struct {
+	registry *data.DataRegistry
+	err      error
+}
+
+func (f *fakeSource) Load() (*data.DataRegistry, error) { return f.registry, f.err }
+func (f *fakeSource) Fingerprint() (string, error)      { return "fixed", nil }
+
+func registryWith(version string) *data.DataRegistry {
+	registry := data.NewDataRegistry()
+	registry.Register(&data.DataSet{SetID: "TFTSet14", Patch: "14.5", Version: version})
+	return registry
+}
+
+func TestDataReloaderSwapsSnapshot(t *testing.T) {
+	simService := NewSimulationService(registryWith("old"))
+	source := &fakeSource{registry: registryWith("new")}
+	reloader := NewDataReloader(simService, source)
+
+	inFlight, err := simService.DataSet("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := reloader.Reload(); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	current, _ := simService.DataSet("", "")
+	if current.Version != "new" {
+		t.Errorf("expected new snapshot after reload; got %s", current.Version)
+	}
+	if inFlight.Version != "old" {
+		t.Errorf("snapshot resolved before the reload changed to %s", inFlight.Version)
+	}
+}
+
+func TestDataReloaderKeepsDataOnFailure(t *testing.T) {
+	simService := NewSimulationService(registryWith("old"))
+	reloader := NewDataReloader(simService, &fakeSource{err: errors.New("bad json")})
+
+	status, err := reloader.Reload()
+	if err == nil {
+		t.Fatal("expected reload error")
+	}
+	if status.Error != "bad json" {
+		t.Errorf("expected error in status; got %q", status.Error)
+	}
+	current, _ := simService.DataSet("", "")
+	if current.Version != "old" {
+		t.Errorf("failed reload replaced data with %s", current.Version)
+	}
+	if reloader.Status().LastSuccess.IsZero() {
+		t.Error("expected last success from the initial load")
+	}
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Scenario is a named simulation request stored in a JSON file, for headless runs (see cmd/tftsim).
+// The request fields (boardChampions, enemies, config, seed, ...) sit at the top level of the file.
+type Scenario struct {
+	Name string `json:"name,omitempty"`
+	RunSimulationRequest
+}
+
+// LoadScenarioFile reads a scenario from a JSON file. Unknown fields are rejected so typos
+// do not silently fall back to defaults.
+func LoadScenarioFile(filePath string) (*Scenario, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading scenario: %w", err)
+	}
+	defer file.Close()
+
+	var scenario Scenario
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&scenario); err != nil {
+		return nil, fmt.Errorf("error parsing scenario %s: %w", filePath, err)
+	}
+	if scenario.Name == "" {
+		scenario.Name = filePath
+	}
+	return &scenario, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+func TestLoadScenarioFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "kog.json")
+	content := `{"name":"kog","boardChampions":[{"apiName":"TFT14_KogMaw","stars":2}],"enemies":[{"hp":5000}],"config":{"maxTime":20},"jitter":true,"seed":7}`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	scenario, err := LoadScenarioFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scenario.Name != "kog" || scenario.Seed != 7 || len(scenario.BoardChampions) != 1 || scenario.Enemies[0].HP != 5000 || scenario.Config.MaxTime != 20 {
+		t.Errorf("scenario not decoded as expected: %+v", scenario)
+	}
+
+	config := (&SimulationService{}).simulationConfig(scenario.RunSimulationRequest)
+	if config.MaxTime != 20 || config.Seed != 7 || !config.Jitter {
+		t.Errorf("expected scenario settings in config; got %+v", config)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"board":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadScenarioFile(path); err == nil {
+		t.Error("expected unknown fields to be rejected")
+	}
+}
+
+func TestSeedDoesNotChangeResultsWithoutJitter(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want []ArchiveEvent
+	for _, seed := range []int64{1, 2, 99} {
+		req := scenario.RunSimulationRequest
+		req.Seed = seed
+		archive, err := simService.RecordArchive(context.Background(), req)
+		if err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		if want == nil {
+			want = archive.Events
+			continue
+		}
+		if len(archive.Events) != len(want) {
+			t.Fatalf("seed %d: %d events, seed 1 had %d", seed, len(archive.Events), len(want))
+		}
+		for i, evt := range archive.Events {
+			if evt.Type != want[i].Type || evt.Timestamp != want[i].Timestamp || string(evt.Payload) != string(want[i].Payload) {
+				t.Fatalf("seed %d: event %d is %s at %.4f, seed 1 had %s at %.4f", seed, i,
+					evt.Type, evt.Timestamp, want[i].Type, want[i].Timestamp)
+			}
+		}
+	}
+}
@@ -1,46 +1,221 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"sync/atomic"
 	"time"
 
+	"tft-dps-simulator/internal/cache"
+	"tft-dps-simulator/internal/core/components"
 	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/ecs"
 	"tft-dps-simulator/internal/core/entity"
 	"tft-dps-simulator/internal/core/factory"
 	"tft-dps-simulator/internal/core/managers"
 	"tft-dps-simulator/internal/core/simulation"
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+)
+
+// Target dummy used when a request names no enemies, and the defaults for enemy fields left empty.
+const (
+	DefaultEnemyApiName = "TFT_TrainingDummy"
+	DefaultEnemyHP      = 1000000.0
 )
 
 // SimulationService handles the logic for running combat simulations.
 type SimulationService struct {
-	loadedData *data.TFTSetData // Access to game data (champions, items, etc.)
+	dataRegistry atomic.Pointer[data.DataRegistry] // Loaded sets and patches, selectable per request
+	resultCache  cache.ResultCache                 // Optional cache of results by canonical board hash
 }
 
 // NewSimulationService creates a new SimulationService.
-func NewSimulationService(loadedData *data.TFTSetData) *SimulationService {
-	return &SimulationService{
-		loadedData: loadedData,
+func NewSimulationService(dataRegistry *data.DataRegistry) *SimulationService {
+	s := &SimulationService{}
+	s.dataRegistry.Store(dataRegistry)
+	return s
+}
+
+// SetDataRegistry swaps in a new data snapshot. Simulations already running keep the
+// DataSet they resolved when they started; only requests arriving afterwards see the new data.
+func (s *SimulationService) SetDataRegistry(dataRegistry *data.DataRegistry) {
+	s.dataRegistry.Store(dataRegistry)
+}
+
+// DataSet returns the loaded data for setID and patch (empty values select the defaults).
+func (s *SimulationService) DataSet(setID, patch string) (*data.DataSet, error) {
+	return s.dataRegistry.Load().Get(setID, patch)
+}
+
+// DataSets lists every loaded set and patch.
+func (s *SimulationService) DataSets() []data.DataSetInfo {
+	return s.dataRegistry.Load().List()
+}
+
+// dataSetFor resolves the set and patch named in a request and applies its overrides.
+// Errors are ValidationErrors, since both come from the request.
+func (s *SimulationService) dataSetFor(req RunSimulationRequest) (*data.DataSet, error) {
+	ds, err := s.dataRegistry.Load().Get(req.SetID, req.Patch)
+	if err != nil {
+		return nil, ValidationErrors{{Field: "setId", Code: CodeUnknownDataSet, Message: err.Error()}}
+	}
+	if req.Overrides == nil {
+		return ds, nil
+	}
+	ds, err = ds.WithOverrides(*req.Overrides, data.OverrideSourceRequest)
+	if err != nil {
+		return nil, ValidationErrors{{Field: "overrides", Code: CodeInvalidOverride, Message: err.Error()}}
 	}
+	return ds, nil
+}
+
+// SetResultCache enables caching of simulation results. Pass nil to disable.
+func (s *SimulationService) SetResultCache(resultCache cache.ResultCache) {
+	s.resultCache = resultCache
 }
 
-// RunSimulation executes a combat simulation based on the provided request.
+// RunSimulation executes a combat simulation on the default data set.
 func (s *SimulationService) RunSimulation(requestChampions []BoardChampion) (*RunSimulationResponse, error) {
-	log.Println("Starting simulation run...")
+	return s.RunSimulationWithContext(context.Background(), RunSimulationRequest{BoardChampions: requestChampions})
+}
+
+// RunSimulationWithContext runs the request on the data set it selects, stopping the event loop
+// when ctx is cancelled or times out.
+// Results are served from and stored in the result cache when one is configured, except for
+// jittered runs without a seed: those are random samples and must not be reused.
+func (s *SimulationService) RunSimulationWithContext(ctx context.Context, req RunSimulationRequest) (*RunSimulationResponse, error) {
+	ds, err := s.dataSetFor(req)
+	if err != nil {
+		return nil, err
+	}
+	if s.resultCache == nil || (req.Jitter && req.Seed == 0) {
+		return s.runSimulation(ctx, ds, req, nil)
+	}
+
+	key, err := canonicalRequestHash(req, s.simulationConfig(req), ds.Version)
+	if err != nil {
+		log.Printf("Result cache disabled for this request: %v", err)
+		return s.runSimulation(ctx, ds, req, nil)
+	}
+
+	if cached, ok := s.getCachedResult(ctx, key); ok {
+		log.Printf("Result cache hit for board %s", key)
+		return cached, nil
+	}
+
+	resp, err := s.runSimulation(ctx, ds, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.BoardHash = key
+	s.storeCachedResult(ctx, key, resp)
+	return resp, nil
+}
+
+func (s *SimulationService) getCachedResult(ctx context.Context, key string) (*RunSimulationResponse, bool) {
+	encoded, ok, err := s.resultCache.Get(ctx, key)
+	if err != nil {
+		log.Printf("Error reading result cache for %s: %v", key, err)
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+
+	var resp RunSimulationResponse
+	if err := json.Unmarshal(encoded, &resp); err != nil {
+		log.Printf("Error decoding cached result for %s: %v", key, err)
+		return nil, false
+	}
+	resp.Cached = true
+	return &resp, true
+}
+
+func (s *SimulationService) storeCachedResult(ctx context.Context, key string, resp *RunSimulationResponse) {
+	encoded, err := json.Marshal(resp)
+	if err != nil {
+		log.Printf("Error encoding result for cache %s: %v", key, err)
+		return
+	}
+	if err := s.resultCache.Set(ctx, key, encoded); err != nil {
+		log.Printf("Error writing result cache for %s: %v", key, err)
+	}
+}
+
+// runSimulation builds the world, runs the simulation with an optional event observer and collects results.
+func (s *SimulationService) runSimulation(ctx context.Context, ds *data.DataSet, req RunSimulationRequest, observer simulation.EventObserver) (*RunSimulationResponse, error) {
+	log.Printf("Starting simulation run on %s...", ds.Version)
 	startTime := time.Now()
 
+	prepared, err := s.prepareSimulation(ds, req)
+	if err != nil {
+		return nil, err
+	}
+	sim := prepared.sim
+
+	if observer != nil {
+		sim.SetEventObserver(observer)
+	}
+
+	log.Println("Running simulation...")
+	if err := sim.RunSimulationWithContext(ctx); err != nil {
+		log.Printf("Simulation did not complete: %v", err)
+		return nil, fmt.Errorf("simulation did not complete: %w", err)
+	}
+
+	log.Println("Simulation finished.")
+
+	archievedEvents := make([]ArchivedEvent, 0, len(sim.GetArchiveEvents()))
+
+	for _, event := range sim.GetArchiveEvents() {
+		archivedEvent := ArchivedEvent{
+			EventItem: *event,
+			EventType: eventsys.EventTypeName(event.Event),
+		}
+		archievedEvents = append(archievedEvents, archivedEvent)
+	}
+
+	// for _, event := range archievedEvents {
+	// 	log.Printf("%s: %+v", event.EventType, event.EventItem)
+	// }
+
+	response := prepared.collectResponse(ds, req)
+	response.ArchieveEvents = archievedEvents
+
+	elapsed := time.Since(startTime)
+	log.Printf("Simulation request processed successfully in %s.", elapsed)
+	return response, nil
+}
+
+// preparedSimulation is a world built from a request, ready to run or replay.
+type preparedSimulation struct {
+	world     *ecs.World
+	sim       *simulation.Simulation
+	config    simulation.SimulationConfig
+	champions []entity.Entity          // Board champions in request order
+	enemies   []entity.Entity          // Target dummies in request order
+	entityMap map[entity.Entity]string // Entity -> ApiName, for champions and dummies
+}
+
+// prepareSimulation creates the champions, items and target dummies of a request and the simulation
+// that will run them. Entity IDs only depend on the request, so a replay sees the same IDs.
+func (s *SimulationService) prepareSimulation(ds *data.DataSet, req RunSimulationRequest) (*preparedSimulation, error) {
+	requestChampions := req.BoardChampions
+
 	// 1. Initialize ECS world
 	world := ecs.NewWorld()
 
 	// 2. Initialize Factory and Managers
-	// Pass world instead of loadedData based on test setup
-	championFactory := factory.NewChampionFactory(world)
-	equipmentManager := managers.NewEquipmentManager(world)
+	// Both look game data up in the data set selected by the request
+	championFactory := factory.NewChampionFactory(world, ds)
+	equipmentManager := managers.NewEquipmentManager(world, ds)
 
-	// Map to link request champion ID (ApiName) to ECS entity ID
-	entityMap := make(map[entity.Entity]string)
-	// var championEntities []entity.Entity
+	prepared := &preparedSimulation{
+		world:     world,
+		entityMap: make(map[entity.Entity]string), // Map to link request champion ID (ApiName) to ECS entity ID
+	}
 
 	// 3. Create Champion Entities from Request
 	log.Printf("Processing %d requested champions...", len(requestChampions))
@@ -51,7 +226,8 @@ func (s *SimulationService) RunSimulation(requestChampions []BoardChampion) (*Ru
 			log.Printf("Error creating champion entity %s: %v. Skipping.", reqChamp.ApiName, err)
 			continue // Or return error
 		}
-		entityMap[entityID] = reqChamp.ApiName // Store the mapping
+		prepared.entityMap[entityID] = reqChamp.ApiName // Store the mapping
+		prepared.champions = append(prepared.champions, entityID)
 
 		log.Printf("Items for champion %s: %v", reqChamp.ApiName, reqChamp.Items)
 
@@ -71,65 +247,57 @@ func (s *SimulationService) RunSimulation(requestChampions []BoardChampion) (*Ru
 
 	// 4. Add Target Dummies (Team 1)
 	log.Println("Adding enemy training dummies")
-	targetDummy, err := championFactory.CreateEnemyChampion("TFT_TrainingDummy", 3)
-	if err != nil {
-		log.Printf("Error creating target dummy: %v", err)
-		return nil, fmt.Errorf("error creating target dummy: %w", err)
-	}
+	for _, enemy := range enemyUnits(req.Enemies) {
+		targetDummy, err := championFactory.CreateEnemyChampion(enemy.ApiName, enemy.Stars)
+		if err != nil {
+			log.Printf("Error creating target dummy: %v", err)
+			return nil, fmt.Errorf("error creating target dummy %s: %w", enemy.ApiName, err)
+		}
 
-	dummyHealth, ok := world.GetHealth(targetDummy)
-	if !ok {
-		log.Printf("Error getting health component for target dummy: %v", err)
-		return nil, fmt.Errorf("error getting health component for target dummy: %w", err)
-	}
-	dummyHealth.SetBaseMaxHP(1000000) // Set dummy health to 1000 for testing
-	dummyHealth.SetBaseMR(0.0)      // Set dummy MR to 0 for testing
-	dummyHealth.SetBaseArmor(0.0)   // Set dummy Armor to 0 for testing
-	dummyAttack, ok := world.GetAttack(targetDummy)
-	if !ok {
-		log.Printf("Error getting attack component for target dummy: %v", err)
-		return nil, fmt.Errorf("error getting attack component for target dummy: %w", err)
+		dummyHealth, ok := world.GetHealth(targetDummy)
+		if !ok {
+			return nil, fmt.Errorf("error getting health component for target dummy %s", enemy.ApiName)
+		}
+		dummyHealth.SetBaseMaxHP(enemy.HP)
+		dummyHealth.SetBaseMR(enemy.MagicResist)
+		dummyHealth.SetBaseArmor(enemy.Armor)
+		dummyAttack, ok := world.GetAttack(targetDummy)
+		if !ok {
+			return nil, fmt.Errorf("error getting attack component for target dummy %s", enemy.ApiName)
+		}
+		dummyAttack.SetBaseAttackSpeed(0.0)
+
+		prepared.entityMap[targetDummy] = enemy.ApiName
+		prepared.enemies = append(prepared.enemies, targetDummy)
 	}
-	dummyAttack.SetBaseAttackSpeed(0.0)
 
-	// 5. Configure and Run Simulation
+	// 5. Configure Simulation
 	log.Println("Configuring simulation...")
-	config := simulation.DefaultConfig()
+	prepared.config = s.simulationConfig(req)
 
 	// Validate config
-	if err := config.Validate(); err != nil {
+	if err := prepared.config.Validate(); err != nil {
 		log.Printf("Invalid simulation config: %v", err)
 		return nil, fmt.Errorf("invalid simulation config: %w", err)
 	}
 
 	// Instantiate simulation using NewSimulationWithConfig based on tests
-	sim := simulation.NewSimulationWithConfig(world, config)
-
-	log.Println("Running simulation...")
-	sim.RunSimulation()
-
-	log.Println("Simulation finished.")
-
-	archievedEvents := make([]ArchivedEvent, 0, len(sim.GetArchiveEvents()))
-
-	for _, event := range sim.GetArchiveEvents() {
-		archivedEvent := ArchivedEvent{
-			EventItem: *event,
-			EventType: fmt.Sprintf("%T", event.Event),
-		}
-		archievedEvents = append(archievedEvents, archivedEvent)
-	}
+	prepared.sim = simulation.NewSimulationWithConfig(world, ds, prepared.config)
+	return prepared, nil
+}
 
-	// for _, event := range archievedEvents {
-	// 	log.Printf("%s: %+v", event.EventType, event.EventItem)
-	// }
+// collectResponse reads the results out of the world after a run or replay.
+// The response does not include the archived events.
+func (p *preparedSimulation) collectResponse(ds *data.DataSet, req RunSimulationRequest) *RunSimulationResponse {
+	world, config := p.world, p.config
 
 	// 6. Process Results
 	log.Println("Processing simulation results")
 	// Use service types instead of server types
 	results := []ChampionSimulationResult{}
 
-	for entityID, apiName := range entityMap {
+	for _, entityID := range p.champions {
+		apiName := p.entityMap[entityID]
 		// Fetch final health to check if alive (example of reading state post-simulation)
 		// Use helper functions like in tests if available, otherwise direct component access
 		healthComp, healthOk := world.GetHealth(entityID)
@@ -160,21 +328,110 @@ func (s *SimulationService) RunSimulation(requestChampions []BoardChampion) (*Ru
 		damageStats.TotalSpellCastCounts = spellCastCount
 		damageStats.DamagePerSecond = damageStats.TotalDamage / config.MaxTime
 
+		defenseStats := components.NewDefenseStats()
+		if ds, ok := world.GetDefenseStats(entityID); ok {
+			ds.FinalizeTimeAlive(config.MaxTime)
+			defenseStats = *ds
+		}
+
 		// Use service types
 		results = append(results, ChampionSimulationResult{
-			ChampionApiName: apiName,
+			ChampionApiName:  apiName,
 			ChampionEntityID: entityID,
-			DamageStats:     *damageStats,
+			DamageStats:      *damageStats,
+			DefenseStats:     defenseStats,
 		})
 	}
 
-	response := &RunSimulationResponse{
-		Results:        results,
-		ArchieveEvents: archievedEvents, // Assign the dereferenced slice
+	targets := []TargetSimulationResult{}
+	for _, targetDummy := range p.enemies {
+		if dummyDefenseStats, ok := world.GetDefenseStats(targetDummy); ok {
+			dummyDefenseStats.FinalizeTimeAlive(config.MaxTime)
+			targets = append(targets, TargetSimulationResult{
+				ApiName:      p.entityMap[targetDummy],
+				EntityID:     targetDummy,
+				DefenseStats: *dummyDefenseStats,
+			})
+		}
 	}
 
-	elapsed := time.Since(startTime)
-	log.Printf("Simulation request processed successfully in %s.", elapsed)
-	return response, nil
+	// 7. Buff/debuff uptime for every entity (including the target dummies)
+	uptimes := buildUptimeResults(world, p.entityMap, config.MaxTime)
+
+	return &RunSimulationResponse{
+		Results:          results,
+		Targets:          targets,
+		Uptimes:          uptimes,
+		Warnings:         coverageWarnings(ds, req.BoardChampions),
+		Notes:            coverageNotes(),
+		DataVersion:      dataVersionInfo(ds),
+		AppliedOverrides: ds.AppliedOverrides,
+	}
+}
+
+func dataVersionInfo(ds *data.DataSet) DataVersionInfo {
+	return DataVersionInfo{SetID: ds.SetID, Patch: ds.Patch, Version: ds.Version}
 }
 
+// simulationConfig returns the config used for a request: the defaults with its settings, jitter and seed applied.
+// The seed is dropped without jitter, where it does not affect the run, so that it does not split cache entries.
+func (s *SimulationService) simulationConfig(req RunSimulationRequest) simulation.SimulationConfig {
+	seed := req.Seed
+	if !req.Jitter {
+		seed = 0
+	}
+	config := simulation.DefaultConfig().WithJitter(req.Jitter).WithSeed(seed)
+	if req.Config != nil && req.Config.MaxTime > 0 {
+		config = config.WithMaxTime(req.Config.MaxTime)
+	}
+	return config
+}
+
+// enemyUnits fills in defaults for the requested enemies, or returns the single default dummy.
+func enemyUnits(requested []EnemyUnit) []EnemyUnit {
+	if len(requested) == 0 {
+		requested = []EnemyUnit{{}}
+	}
+	enemies := make([]EnemyUnit, len(requested))
+	for i, enemy := range requested {
+		if enemy.ApiName == "" {
+			enemy.ApiName = DefaultEnemyApiName
+		}
+		if enemy.Stars == 0 {
+			enemy.Stars = 3
+		}
+		if enemy.HP == 0 {
+			enemy.HP = DefaultEnemyHP
+		}
+		enemies[i] = enemy
+	}
+	return enemies
+}
+
+// buildUptimeResults converts the EffectUptime components in the world into per-entity reports,
+// ordered by entity ID.
+func buildUptimeResults(world *ecs.World, entityNames map[entity.Entity]string, combatDuration float64) []EntityUptimeResult {
+	entities := ecs.Query[components.EffectUptime](world)
+
+	uptimes := make([]EntityUptimeResult, 0, len(entities))
+	for _, e := range entities {
+		uptime, _ := world.GetEffectUptime(e)
+		result := EntityUptimeResult{
+			EntityID: e,
+			ApiName:  entityNames[e],
+			Effects:  []EffectUptimeReport{},
+		}
+		for _, name := range uptime.GetEffectNames() {
+			report := EffectUptimeReport{
+				EffectName:     name,
+				UptimePercent:  uptime.GetUptimePercent(name, combatDuration) * 100,
+				ActiveDuration: uptime.GetActiveDuration(name),
+				Intervals:      uptime.GetIntervals(name),
+			}
+			log.Printf("Uptime: %s on %s (Entity %d): %.1f%% (%.2fs active)", name, result.ApiName, e, report.UptimePercent, report.ActiveDuration)
+			result.Effects = append(result.Effects, report)
+		}
+		uptimes = append(uptimes, result)
+	}
+	return uptimes
+}
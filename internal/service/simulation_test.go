@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"tft-dps-simulator/internal/cache"
+	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/data"
+	"tft-dps-simulator/internal/core/entity"
+)
+
+func TestUnseededJitterRunsAreNotCached(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+	resultCache := cache.NewLRUCache(10, 0)
+	simService.SetResultCache(resultCache)
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := scenario.RunSimulationRequest
+	req.Jitter = true
+	req.Seed = 0
+	for i := 0; i < 2; i++ {
+		resp, err := simService.RunSimulationWithContext(context.Background(), req)
+		if err != nil {
+			t.Fatalf("run %d: %v", i, err)
+		}
+		if resp.BoardHash != "" {
+			t.Errorf("run %d: expected no board hash for an uncached run; got %s", i, resp.BoardHash)
+		}
+	}
+	if resultCache.Len() != 0 {
+		t.Errorf("expected unseeded jitter runs not to be cached; cache holds %d results", resultCache.Len())
+	}
+
+	req.Seed = 7
+	if _, err := simService.RunSimulationWithContext(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if resultCache.Len() != 1 {
+		t.Errorf("expected a seeded jitter run to be cached; cache holds %d results", resultCache.Len())
+	}
+}
+
+func TestCachedResultKeepsEventsWithinByteLimit(t *testing.T) {
+	quietLogs(t)
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := scenario.RunSimulationRequest
+
+	// A cache hit serves the event log like a fresh run
+	simService := NewSimulationService(registry)
+	resultCache := cache.NewLRUCache(10, 0)
+	simService.SetResultCache(resultCache)
+	first, err := simService.RunSimulationWithContext(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hit, err := simService.RunSimulationWithContext(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hit.Cached || len(hit.ArchieveEvents) != len(first.ArchieveEvents) {
+		t.Errorf("expected a cache hit with %d events; got cached=%v with %d", len(first.ArchieveEvents), hit.Cached, len(hit.ArchieveEvents))
+	}
+
+	// A result larger than the byte limit is returned but not kept
+	simService = NewSimulationService(registry)
+	resultCache = cache.NewLRUCache(10, resultCache.Size()-1)
+	simService.SetResultCache(resultCache)
+	if _, err := simService.RunSimulationWithContext(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if resultCache.Len() != 0 || resultCache.Size() != 0 {
+		t.Errorf("expected the oversized result not to be cached; cache holds %d results (%d bytes)", resultCache.Len(), resultCache.Size())
+	}
+}
+
+func TestSeedDoesNotChangeBoardHashWithoutJitter(t *testing.T) {
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+	req := RunSimulationRequest{BoardChampions: []BoardChampion{{ApiName: "TFT14_KogMaw", Stars: 2}}}
+
+	hashes := map[bool][]string{}
+	for _, jitter := range []bool{false, true} {
+		for _, seed := range []int64{1, 2} {
+			req.Jitter, req.Seed = jitter, seed
+			hash, err := simService.BoardHash(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hashes[jitter] = append(hashes[jitter], hash)
+		}
+	}
+	if hashes[false][0] != hashes[false][1] {
+		t.Errorf("expected the seed not to change the hash without jitter")
+	}
+	if hashes[true][0] == hashes[true][1] {
+		t.Errorf("expected the seed to change the hash with jitter")
+	}
+}
+
+func TestRapidfireMaxStacksUptimeStartsOnTheLastStack(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	simService := NewSimulationService(registry)
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := simService.RunSimulationWithContext(context.Background(), scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive, err := simService.RecordArchive(context.Background(), scenario.RunSimulationRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Landing times of every attack, by attacker
+	attacks := map[entity.Entity][]float64{}
+	for _, evt := range archive.Events {
+		if evt.Type != "AttackLandedEvent.v1" {
+			continue
+		}
+		var landed struct{ Source entity.Entity }
+		if err := json.Unmarshal(evt.Payload, &landed); err != nil {
+			t.Fatal(err)
+		}
+		attacks[landed.Source] = append(attacks[landed.Source], evt.Timestamp)
+	}
+
+	const maxStacks = 10 // Rapidfire MaxStacks in the golden data
+	checked := 0
+	for _, entityUptime := range resp.Uptimes {
+		for _, report := range entityUptime.Effects {
+			if report.EffectName != components.UptimeRapidfireMaxStacks {
+				continue
+			}
+			landed := attacks[entityUptime.EntityID]
+			if len(landed) < maxStacks || len(report.Intervals) == 0 {
+				t.Fatalf("%s: %d attacks and uptime %+v", entityUptime.ApiName, len(landed), report.Intervals)
+			}
+			if report.Intervals[0].Start != landed[maxStacks-1] {
+				t.Errorf("%s: max stacks uptime starts at %.4f, the attack adding the last stack landed at %.4f",
+					entityUptime.ApiName, report.Intervals[0].Start, landed[maxStacks-1])
+			}
+			checked++
+		}
+	}
+	if checked != 2 {
+		t.Errorf("expected Rapidfire max stacks uptime for both champions; found %d", checked)
+	}
+}
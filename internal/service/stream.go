@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	eventsys "tft-dps-simulator/internal/core/systems/events"
+)
+
+// SimulationProgress is pushed periodically while a streamed simulation runs.
+type SimulationProgress struct {
+	CurrentTime     float64 `json:"currentTime"`
+	MaxTime         float64 `json:"maxTime"`
+	Percent         float64 `json:"percent"` // 0-100
+	EventsProcessed int     `json:"eventsProcessed"`
+}
+
+// SimulationStreamHandler receives events and progress from StreamSimulation.
+// Returning false from either callback cancels the simulation.
+type SimulationStreamHandler struct {
+	OnEvent    func(evt ArchivedEvent) bool
+	OnProgress func(progress SimulationProgress) bool
+}
+
+// StreamSimulation runs a simulation like RunSimulation, but pushes every processed event
+// and periodic progress (every ReportingInterval simulated seconds) to the handler as they happen.
+// The returned response does not include the archived events, since they were already streamed.
+// The run stops with ctx.Err() when ctx is cancelled or its deadline passes.
+func (s *SimulationService) StreamSimulation(ctx context.Context, req RunSimulationRequest, handler SimulationStreamHandler) (*RunSimulationResponse, error) {
+	ds, err := s.dataSetFor(req)
+	if err != nil {
+		return nil, err
+	}
+
+	config := s.simulationConfig(req)
+	reportingInterval := config.ReportingInterval
+	if reportingInterval <= 0 {
+		reportingInterval = config.MaxTime
+	}
+
+	eventsProcessed := 0
+	nextReportTime := reportingInterval
+	cancelled := false
+
+	observer := func(item *eventsys.EventItem, currentTime float64) bool {
+		eventsProcessed++
+
+		if handler.OnEvent != nil {
+			archivedEvent := ArchivedEvent{
+				EventItem: *item,
+				EventType: eventsys.EventTypeName(item.Event),
+			}
+			if !handler.OnEvent(archivedEvent) {
+				cancelled = true
+				return false
+			}
+		}
+
+		if handler.OnProgress != nil && currentTime >= nextReportTime {
+			for nextReportTime <= currentTime {
+				nextReportTime += reportingInterval
+			}
+			if !handler.OnProgress(newSimulationProgress(currentTime, config.MaxTime, eventsProcessed)) {
+				cancelled = true
+				return false
+			}
+		}
+		return true
+	}
+
+	resp, err := s.runSimulation(ctx, ds, req, observer)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled {
+		log.Printf("Streamed simulation cancelled by client after %d events.", eventsProcessed)
+		return nil, fmt.Errorf("simulation cancelled after %d events", eventsProcessed)
+	}
+
+	if handler.OnProgress != nil {
+		handler.OnProgress(newSimulationProgress(config.MaxTime, config.MaxTime, eventsProcessed))
+	}
+
+	resp.ArchieveEvents = nil
+	return resp, nil
+}
+
+func newSimulationProgress(currentTime, maxTime float64, eventsProcessed int) SimulationProgress {
+	percent := 100.0
+	if maxTime > 0 && currentTime < maxTime {
+		percent = currentTime / maxTime * 100
+	}
+	return SimulationProgress{
+		CurrentTime:     currentTime,
+		MaxTime:         maxTime,
+		Percent:         percent,
+		EventsProcessed: eventsProcessed,
+	}
+}
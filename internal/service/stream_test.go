@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+// recordingStream is a SimulationStreamHandler that keeps what it was sent.
+type recordingStream struct {
+	events    []ArchivedEvent
+	progress  []SimulationProgress
+	stopAfter int // Return false from OnEvent after this many events (0 to never stop)
+}
+
+func (r *recordingStream) handler() SimulationStreamHandler {
+	return SimulationStreamHandler{
+		OnEvent: func(evt ArchivedEvent) bool {
+			r.events = append(r.events, evt)
+			return r.stopAfter == 0 || len(r.events) < r.stopAfter
+		},
+		OnProgress: func(progress SimulationProgress) bool {
+			r.progress = append(r.progress, progress)
+			return true
+		},
+	}
+}
+
+func newStreamTestService(t *testing.T) (*SimulationService, RunSimulationRequest) {
+	t.Helper()
+	quietLogs(t)
+	registry, err := data.FileSource{Dir: goldenDir, SetIDs: []string{"TFTSet14"}, DefaultSet: "TFTSet14", DefaultPatch: "golden"}.Load()
+	if err != nil {
+		t.Fatalf("error loading golden data: %v", err)
+	}
+	scenario, err := LoadScenarioFile(filepath.Join(goldenDir, "scenarios", "rapidfire_pair.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewSimulationService(registry), scenario.RunSimulationRequest
+}
+
+func TestStreamSimulationMatchesRun(t *testing.T) {
+	simService, req := newStreamTestService(t)
+
+	stream := &recordingStream{}
+	resp, err := simService.StreamSimulation(context.Background(), req, stream.handler())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.ArchieveEvents != nil {
+		t.Errorf("expected the streamed response to leave out the events; got %d", len(resp.ArchieveEvents))
+	}
+
+	if len(stream.progress) < 2 {
+		t.Fatalf("expected periodic progress; got %v", stream.progress)
+	}
+	maxTime := stream.progress[0].MaxTime
+
+	// Events arrive in dispatch order, the same as the archive of a plain run. The archive also
+	// holds the event past MaxTime that ended the loop, which is dequeued but never dispatched.
+	run, err := simService.RunSimulationWithContext(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dispatched []ArchivedEvent
+	for _, archived := range run.ArchieveEvents {
+		if archived.EventItem.Timestamp <= maxTime {
+			dispatched = append(dispatched, archived)
+		}
+	}
+	if len(stream.events) != len(dispatched) {
+		t.Fatalf("streamed %d events; the run dispatched %d", len(stream.events), len(dispatched))
+	}
+	versioned := regexp.MustCompile(`^[A-Za-z]+\.v[0-9]+$`)
+	for i, evt := range stream.events {
+		archived := dispatched[i]
+		if evt.EventType != archived.EventType || evt.EventItem.Timestamp != archived.EventItem.Timestamp || evt.EventItem.Sequence != archived.EventItem.Sequence {
+			t.Fatalf("event %d: streamed %s at %.4f (#%d), the run dispatched %s at %.4f (#%d)", i,
+				evt.EventType, evt.EventItem.Timestamp, evt.EventItem.Sequence,
+				archived.EventType, archived.EventItem.Timestamp, archived.EventItem.Sequence)
+		}
+		if !versioned.MatchString(evt.EventType) {
+			t.Errorf("event %d: expected a versioned event type; got %q", i, evt.EventType)
+		}
+	}
+
+	for i := 1; i < len(stream.progress); i++ {
+		if stream.progress[i].Percent < stream.progress[i-1].Percent {
+			t.Errorf("progress went back from %.1f%% to %.1f%%", stream.progress[i-1].Percent, stream.progress[i].Percent)
+		}
+	}
+	if last := stream.progress[len(stream.progress)-1]; last.Percent != 100 || last.EventsProcessed != len(stream.events) {
+		t.Errorf("expected the final progress at 100%% after every event; got %+v", last)
+	}
+}
+
+func TestStreamSimulationStopsWhenHandlerDeclines(t *testing.T) {
+	simService, req := newStreamTestService(t)
+
+	stream := &recordingStream{stopAfter: 5}
+	resp, err := simService.StreamSimulation(context.Background(), req, stream.handler())
+	if err == nil || resp != nil {
+		t.Fatalf("expected the declined stream to fail; got %v, %v", resp, err)
+	}
+	if len(stream.events) != 5 {
+		t.Errorf("expected no events after the handler declined; got %d", len(stream.events))
+	}
+}
+
+func TestStreamSimulationStopsOnContext(t *testing.T) {
+	simService, req := newStreamTestService(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream := &recordingStream{}
+	if _, err := simService.StreamSimulation(ctx, req, stream.handler()); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled; got %v", err)
+	}
+}
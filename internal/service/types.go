@@ -2,6 +2,7 @@ package service
 
 import (
 	"tft-dps-simulator/internal/core/components"
+	"tft-dps-simulator/internal/core/data"
 	"tft-dps-simulator/internal/core/entity"
 	eventsys "tft-dps-simulator/internal/core/systems/events"
 )
@@ -23,7 +24,7 @@ type Item struct {
 // BoardChampion matches frontend/src/utils/types.ts (simplified for simulation input)
 type BoardChampion struct {
 	ApiName  string        `json:"apiName"` // Use ApiName as identifier
-	Stars    int           `json:"stars"` // Default to 1 if missing? Frontend uses 1 | 2 | 3
+	Stars    int           `json:"stars"`   // Default to 1 if missing? Frontend uses 1 | 2 | 3
 	Items    []Item        `json:"items"`
 	Position BoardPosition `json:"position"`
 	// Name, Cost, Traits, Image might not be needed if ID is sufficient for lookup.
@@ -32,24 +33,85 @@ type BoardChampion struct {
 // RunSimulationRequest is the expected request body structure
 type RunSimulationRequest struct {
 	BoardChampions []BoardChampion `json:"boardChampions"`
+	SetID          string          `json:"setId,omitempty"`     // Set mutator, e.g. "TFTSet14" (empty for the default set)
+	Patch          string          `json:"patch,omitempty"`     // Patch label, e.g. "14.5" (empty for the default patch)
+	Level          int             `json:"level,omitempty"`     // Player level, caps the unit count (0 means MaxPlayerLevel)
+	Lenient        bool            `json:"lenient,omitempty"`   // Report validation problems as warnings instead of rejecting the request
+	Overrides      *data.Overrides `json:"overrides,omitempty"` // Balance changes applied to this run only
+	Enemies        []EnemyUnit     `json:"enemies,omitempty"`   // Target dummies to attack (empty for a single default dummy)
+	Config         *RunConfig      `json:"config,omitempty"`    // Simulation settings (nil for the defaults)
+	Jitter         bool            `json:"jitter,omitempty"`    // Randomize the order of simultaneous events (for Monte Carlo runs)
+	Seed           int64           `json:"seed,omitempty"`      // Jitter seed; equal seeds give equal results (0 for random)
 	// We could add other context later if needed, like selected Augments
 	// SelectedAugments []Augment `json:"selectedAugments"`
 }
 
+// EnemyUnit is a target dummy on the enemy team. Dummies do not attack.
+type EnemyUnit struct {
+	ApiName     string  `json:"apiName,omitempty"`     // Unit to spawn (default TFT_TrainingDummy)
+	Stars       int     `json:"stars,omitempty"`       // Star level (default 3)
+	HP          float64 `json:"hp,omitempty"`          // Max HP (default 1,000,000)
+	Armor       float64 `json:"armor,omitempty"`       // Armor (default 0)
+	MagicResist float64 `json:"magicResist,omitempty"` // Magic resist (default 0)
+}
+
+// RunConfig holds the simulation settings a request may change.
+type RunConfig struct {
+	MaxTime float64 `json:"maxTime,omitempty"` // Combat length in seconds (default 30)
+}
+
 // ChampionSimulationResult holds the results for a single champion
 type ChampionSimulationResult struct {
-	ChampionApiName  string      `json:"championApiName"` // Match the ApiName sent in the request
-	ChampionEntityID entity.Entity `json:"championEntityId"` // Entity ID in ECS world
-	DamageStats components.DamageStats `json:"damageStats"`
+	ChampionApiName  string                  `json:"championApiName"`  // Match the ApiName sent in the request
+	ChampionEntityID entity.Entity           `json:"championEntityId"` // Entity ID in ECS world
+	DamageStats      components.DamageStats  `json:"damageStats"`
+	DefenseStats     components.DefenseStats `json:"defenseStats"`
+}
+
+// TargetSimulationResult holds the defensive results for a target dummy
+type TargetSimulationResult struct {
+	ApiName      string                  `json:"apiName"`
+	EntityID     entity.Entity           `json:"entityId"`
+	DefenseStats components.DefenseStats `json:"defenseStats"`
+}
+
+// EffectUptimeReport summarizes how long a single buff or debuff was active on an entity
+type EffectUptimeReport struct {
+	EffectName     string                      `json:"effectName"`
+	UptimePercent  float64                     `json:"uptimePercent"`  // 0-100, relative to combat duration
+	ActiveDuration float64                     `json:"activeDuration"` // Total seconds active
+	Intervals      []components.UptimeInterval `json:"intervals"`
+}
+
+// EntityUptimeResult holds buff/debuff uptime for a single entity (champions and target dummies)
+type EntityUptimeResult struct {
+	EntityID entity.Entity        `json:"entityId"`
+	ApiName  string               `json:"apiName"`
+	Effects  []EffectUptimeReport `json:"effects"`
 }
 
 type ArchivedEvent struct {
 	EventItem eventsys.EventItem `json:"eventItem"`
-	EventType string `json:"eventType"` // Type of event (e.g., "damage", "heal", etc.)
+	EventType string             `json:"eventType"` // Versioned event type name, e.g. "DamageAppliedEvent.v1" (see eventsys.EventTypes)
 }
 
 // RunSimulationResponse is the structure of the response body
 type RunSimulationResponse struct {
-	Results []ChampionSimulationResult `json:"results"`
-	ArchieveEvents []ArchivedEvent `json:"archieveEvents"`
-}
\ No newline at end of file
+	Results          []ChampionSimulationResult `json:"results"`
+	Targets          []TargetSimulationResult   `json:"targets"`
+	ArchieveEvents   []ArchivedEvent            `json:"archieveEvents"`
+	Uptimes          []EntityUptimeResult       `json:"uptimes"`
+	BoardHash        string                     `json:"boardHash,omitempty"`        // Canonical hash of the request (set when caching is enabled)
+	Cached           bool                       `json:"cached"`                     // True when served from the result cache
+	Warnings         []string                   `json:"warnings,omitempty"`         // Parts of the board the simulator does not model (see coverage.go)
+	Notes            []string                   `json:"notes,omitempty"`            // Caveats that apply to every simulation (see coverage.go)
+	DataVersion      DataVersionInfo            `json:"dataVersion"`                // Set and patch the simulation ran on
+	AppliedOverrides []data.AppliedOverride     `json:"appliedOverrides,omitempty"` // Balance overrides from the overrides file and the request
+}
+
+// DataVersionInfo identifies the game data a simulation ran on
+type DataVersionInfo struct {
+	SetID   string `json:"setId"`
+	Patch   string `json:"patch"`
+	Version string `json:"version"`
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+// Board limits enforced by ValidateRequest. Positions are 0-based, matching the frontend grid.
+const (
+	BoardRows           = 4
+	BoardCols           = 7
+	MaxItemsPerChampion = 3
+	MinStars            = 1
+	MaxStars            = 3
+	MaxPlayerLevel      = 10
+	MaxEnemies          = BoardRows * BoardCols
+	MaxCombatTime       = 300.0 // Seconds
+)
+
+// Validation error codes.
+const (
+	CodeRequired        = "required"
+	CodeUnknownChampion = "unknown_champion"
+	CodeInvalidStars    = "invalid_stars"
+	CodeTooManyItems    = "too_many_items"
+	CodeUnknownItem     = "unknown_item"
+	CodeDuplicateUnique = "duplicate_unique"
+	CodeOffBoard        = "off_board"
+	CodeOverlapping     = "overlapping_position"
+	CodeInvalidLevel    = "invalid_level"
+	CodeTooManyUnits    = "too_many_units"
+	CodeUnknownDataSet  = "unknown_data_set"
+	CodeInvalidOverride = "invalid_override"
+	CodeUnknownEnemy    = "unknown_enemy"
+	CodeInvalidEnemy    = "invalid_enemy"
+	CodeInvalidConfig   = "invalid_config"
+)
+
+// fatalCodes are problems the simulation cannot skip over, so lenient requests fail on them too.
+var fatalCodes = map[string]bool{
+	CodeRequired:      true,
+	CodeUnknownEnemy:  true,
+	CodeInvalidEnemy:  true,
+	CodeInvalidConfig: true,
+}
+
+// ValidationError describes a single problem with a request field.
+type ValidationError struct {
+	Field   string `json:"field"` // e.g. "boardChampions[1].items[2]"
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors is the list of problems found in a request.
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, fmt.Sprintf("%s: %s", err.Field, err.Message))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Warnings formats the errors as response warnings, for lenient requests.
+func (errs ValidationErrors) Warnings() []string {
+	warnings := make([]string, 0, len(errs))
+	for _, err := range errs {
+		warnings = append(warnings, fmt.Sprintf("%s: %s", err.Field, err.Message))
+	}
+	return warnings
+}
+
+// ValidateRequest checks a simulation request against the data set and board rules.
+// It returns nil when the request is valid.
+func ValidateRequest(ds *data.DataSet, req RunSimulationRequest) ValidationErrors {
+	var errs ValidationErrors
+	add := func(field, code, format string, args ...interface{}) {
+		errs = append(errs, ValidationError{Field: field, Code: code, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(req.BoardChampions) == 0 {
+		add("boardChampions", CodeRequired, "BoardChampions array cannot be empty")
+		return errs
+	}
+
+	maxUnits := MaxPlayerLevel
+	if req.Level != 0 {
+		if req.Level < 1 || req.Level > MaxPlayerLevel {
+			add("level", CodeInvalidLevel, "level must be between 1 and %d, got %d", MaxPlayerLevel, req.Level)
+		} else {
+			maxUnits = req.Level
+		}
+	}
+	if len(req.BoardChampions) > maxUnits {
+		add("boardChampions", CodeTooManyUnits, "%d units on board but level %d allows %d", len(req.BoardChampions), maxUnits, maxUnits)
+	}
+
+	occupied := make(map[BoardPosition]int)
+	for i, champ := range req.BoardChampions {
+		field := fmt.Sprintf("boardChampions[%d]", i)
+
+		if ds.GetChampionByApiName(champ.ApiName) == nil {
+			add(field+".apiName", CodeUnknownChampion, "unknown champion %q", champ.ApiName)
+		}
+		if champ.Stars < MinStars || champ.Stars > MaxStars {
+			add(field+".stars", CodeInvalidStars, "stars must be between %d and %d, got %d", MinStars, MaxStars, champ.Stars)
+		}
+
+		pos := champ.Position
+		if pos.Row < 0 || pos.Row >= BoardRows || pos.Col < 0 || pos.Col >= BoardCols {
+			add(field+".position", CodeOffBoard, "position (%d, %d) is outside the %dx%d board", pos.Row, pos.Col, BoardRows, BoardCols)
+		} else if other, taken := occupied[pos]; taken {
+			add(field+".position", CodeOverlapping, "position (%d, %d) is already taken by boardChampions[%d]", pos.Row, pos.Col, other)
+		} else {
+			occupied[pos] = i
+		}
+
+		if len(champ.Items) > MaxItemsPerChampion {
+			add(field+".items", CodeTooManyItems, "%d items equipped, at most %d allowed", len(champ.Items), MaxItemsPerChampion)
+		}
+		uniques := make(map[string]bool)
+		for j, reqItem := range champ.Items {
+			itemField := fmt.Sprintf("%s.items[%d]", field, j)
+			item := ds.GetItemByApiName(reqItem.ApiName)
+			if item == nil {
+				add(itemField, CodeUnknownItem, "unknown item %q", reqItem.ApiName)
+				continue
+			}
+			if item.Unique {
+				if uniques[item.ApiName] {
+					add(itemField, CodeDuplicateUnique, "unique item %s is already equipped on this champion", item.ApiName)
+				}
+				uniques[item.ApiName] = true
+			}
+		}
+	}
+
+	if len(req.Enemies) > MaxEnemies {
+		add("enemies", CodeTooManyUnits, "%d enemies, at most %d allowed", len(req.Enemies), MaxEnemies)
+	}
+	for i, enemy := range req.Enemies {
+		field := fmt.Sprintf("enemies[%d]", i)
+		if enemy.ApiName != "" && ds.GetChampionByApiName(enemy.ApiName) == nil {
+			add(field+".apiName", CodeUnknownEnemy, "unknown unit %q", enemy.ApiName)
+		}
+		if enemy.Stars != 0 && (enemy.Stars < MinStars || enemy.Stars > MaxStars) {
+			add(field+".stars", CodeInvalidEnemy, "stars must be between %d and %d, got %d", MinStars, MaxStars, enemy.Stars)
+		}
+		if enemy.HP < 0 || enemy.Armor < 0 || enemy.MagicResist < 0 {
+			add(field, CodeInvalidEnemy, "hp, armor and magicResist cannot be negative")
+		}
+	}
+
+	if req.Config != nil && (req.Config.MaxTime < 0 || req.Config.MaxTime > MaxCombatTime) {
+		add("config.maxTime", CodeInvalidConfig, "maxTime must be between 0 and %g seconds, got %g", MaxCombatTime, req.Config.MaxTime)
+	}
+	return errs
+}
+
+// CheckRequest validates req. Strict requests fail with ValidationErrors on any problem.
+// Lenient requests only fail on an empty board, bad enemies or bad config; other problems come
+// back as warnings and the simulation skips the offending units and items, as it always has.
+func CheckRequest(ds *data.DataSet, req RunSimulationRequest) ([]string, error) {
+	errs := ValidateRequest(ds, req)
+	if len(errs) == 0 {
+		return nil, nil
+	}
+	if !req.Lenient {
+		return nil, errs
+	}
+	for _, err := range errs {
+		if fatalCodes[err.Code] {
+			return nil, errs
+		}
+	}
+	return errs.Warnings(), nil
+}
+
+// CheckRequest resolves the data set named by req and validates the request against it.
+// An unknown set or patch, or an invalid override, is always an error, even for lenient requests.
+func (s *SimulationService) CheckRequest(req RunSimulationRequest) ([]string, error) {
+	if len(req.BoardChampions) == 0 {
+		// Nothing to look up; report the empty board without resolving data
+		return CheckRequest(nil, req)
+	}
+	ds, err := s.dataSetFor(req)
+	if err != nil {
+		return nil, err
+	}
+	return CheckRequest(ds, req)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"tft-dps-simulator/internal/core/data"
+)
+
+func TestValidateRequest(t *testing.T) {
+	ds := &data.DataSet{
+		Champions: map[string]*data.Champion{"TFT14_Jinx": {ApiName: "TFT14_Jinx"}},
+		Items: map[string]*data.Item{
+			data.TFT_Item_InfinityEdge: {ApiName: data.TFT_Item_InfinityEdge},
+			"TFT_Item_Unique":          {ApiName: "TFT_Item_Unique", Unique: true},
+		},
+	}
+	ie := Item{ApiName: data.TFT_Item_InfinityEdge}
+
+	valid := RunSimulationRequest{BoardChampions: []BoardChampion{
+		{ApiName: "TFT14_Jinx", Stars: 2, Items: []Item{ie, ie, ie}, Position: BoardPosition{Row: 3, Col: 6}},
+	}}
+	if errs := ValidateRequest(ds, valid); errs != nil {
+		t.Fatalf("expected valid request; got %v", errs)
+	}
+
+	invalid := RunSimulationRequest{
+		Level: 1,
+		BoardChampions: []BoardChampion{
+			{ApiName: "TFT14_Nobody", Stars: 4, Position: BoardPosition{Row: 4, Col: 0}},
+			{ApiName: "TFT14_Jinx", Stars: 1, Items: []Item{ie, ie, ie, ie}},
+			{ApiName: "TFT14_Jinx", Stars: 1, Items: []Item{{ApiName: "TFT_Item_Unique"}, {ApiName: "TFT_Item_Unique"}, {ApiName: "TFT_Item_Nope"}}},
+		},
+	}
+	want := map[string]string{
+		"boardChampions":             CodeTooManyUnits,
+		"boardChampions[0].apiName":  CodeUnknownChampion,
+		"boardChampions[0].stars":    CodeInvalidStars,
+		"boardChampions[0].position": CodeOffBoard,
+		"boardChampions[1].items":    CodeTooManyItems,
+		"boardChampions[2].position": CodeOverlapping,
+		"boardChampions[2].items[1]": CodeDuplicateUnique,
+		"boardChampions[2].items[2]": CodeUnknownItem,
+	}
+	errs := ValidateRequest(ds, invalid)
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors; got %v", len(want), errs)
+	}
+	for _, err := range errs {
+		if want[err.Field] != err.Code {
+			t.Errorf("%s: expected code %q; got %q", err.Field, want[err.Field], err.Code)
+		}
+	}
+
+	if _, err := CheckRequest(ds, invalid); !errors.As(err, &ValidationErrors{}) {
+		t.Errorf("expected strict CheckRequest to fail with ValidationErrors; got %v", err)
+	}
+	invalid.Lenient = true
+	warnings, err := CheckRequest(ds, invalid)
+	if err != nil || len(warnings) != len(want) {
+		t.Errorf("expected lenient CheckRequest to return %d warnings; got %v, %v", len(want), warnings, err)
+	}
+	if _, err := CheckRequest(ds, RunSimulationRequest{Lenient: true}); err == nil {
+		t.Errorf("expected an empty board to be rejected even in lenient mode")
+	}
+}
+
+func TestValidateEnemiesAndConfig(t *testing.T) {
+	ds := &data.DataSet{
+		Champions: map[string]*data.Champion{
+			"TFT14_Jinx":        {ApiName: "TFT14_Jinx"},
+			DefaultEnemyApiName: {ApiName: DefaultEnemyApiName},
+		},
+	}
+	req := RunSimulationRequest{
+		Lenient:        true,
+		BoardChampions: []BoardChampion{{ApiName: "TFT14_Jinx", Stars: 1}},
+		Enemies:        []EnemyUnit{{}, {ApiName: "TFT14_Nobody", Stars: 5, Armor: -1}},
+		Config:         &RunConfig{MaxTime: MaxCombatTime + 1},
+	}
+	want := map[string]string{
+		"enemies[1].apiName": CodeUnknownEnemy,
+		"enemies[1].stars":   CodeInvalidEnemy,
+		"enemies[1]":         CodeInvalidEnemy,
+		"config.maxTime":     CodeInvalidConfig,
+	}
+	errs := ValidateRequest(ds, req)
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors; got %v", len(want), errs)
+	}
+	for _, err := range errs {
+		if want[err.Field] != err.Code {
+			t.Errorf("%s: expected code %q; got %q", err.Field, want[err.Field], err.Code)
+		}
+	}
+	if _, err := CheckRequest(ds, req); err == nil {
+		t.Error("expected enemy and config problems to fail lenient requests")
+	}
+}
@@ -5,13 +5,16 @@ import (
 	"log"
 	"os"
 	"os/signal"
-	"path/filepath"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 	"fmt"
 
+	"tft-dps-simulator/internal/boards"
+	"tft-dps-simulator/internal/cache"
 	"tft-dps-simulator/internal/core/data" // Import data package
+	"tft-dps-simulator/internal/database"
 	"tft-dps-simulator/internal/server"
 	"tft-dps-simulator/internal/service" // Import service package
 
@@ -35,7 +38,7 @@ func gracefulShutdown(fiberServer *server.FiberServer, done chan bool) {
 	ctxTimeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := fiberServer.App.ShutdownWithContext(ctxTimeout); err != nil {
+	if err := fiberServer.ShutdownWithContext(ctxTimeout); err != nil {
 		log.Printf("Server forced to shutdown with error: %v", err)
 	}
 
@@ -45,30 +48,131 @@ func gracefulShutdown(fiberServer *server.FiberServer, done chan bool) {
 	done <- true
 }
 
+// redisService lazily creates the Redis connection shared by the result cache and board store.
+func redisService() database.Service {
+	if sharedRedis == nil {
+		sharedRedis = database.New()
+	}
+	return sharedRedis
+}
+
+var sharedRedis database.Service
+
+// newResultCache picks the simulation result cache from RESULT_CACHE ("memory" (default), "redis" or "none").
+// The memory cache holds RESULT_CACHE_SIZE entries (default 256) up to RESULT_CACHE_BYTES (default 128 MiB).
+func newResultCache() cache.ResultCache {
+	switch os.Getenv("RESULT_CACHE") {
+	case "none":
+		log.Println("Result cache disabled")
+		return nil
+	case "redis":
+		log.Println("Using Redis result cache")
+		return cache.NewRedisCache(redisService(), "tftsim:result:", 24*time.Hour)
+	default:
+		size, err := strconv.Atoi(os.Getenv("RESULT_CACHE_SIZE"))
+		if err != nil || size <= 0 {
+			size = 256
+		}
+		maxBytes, err := strconv.Atoi(os.Getenv("RESULT_CACHE_BYTES"))
+		if err != nil || maxBytes <= 0 {
+			maxBytes = 128 << 20
+		}
+		log.Printf("Using in-memory result cache (%d entries, %d MiB)", size, maxBytes>>20)
+		return cache.NewLRUCache(size, maxBytes)
+	}
+}
+
+// newBoardRepository picks the saved board store from BOARD_STORE ("memory" (default), "file" or "redis").
+// The file store writes to BOARD_STORE_DIR (default ./data/boards).
+func newBoardRepository() boards.Repository {
+	switch os.Getenv("BOARD_STORE") {
+	case "redis":
+		log.Println("Using Redis board store")
+		return boards.NewRedisRepository(redisService())
+	case "file":
+		dir := os.Getenv("BOARD_STORE_DIR")
+		if dir == "" {
+			dir = "./data/boards"
+		}
+		repo, err := boards.NewFileRepository(dir)
+		if err != nil {
+			log.Printf("Error creating file board store, falling back to memory: %v", err)
+			return boards.NewMemoryRepository()
+		}
+		log.Printf("Using file board store in %s", dir)
+		return repo
+	default:
+		log.Println("Using in-memory board store")
+		return boards.NewMemoryRepository()
+	}
+}
+
+// dataSource describes the game data files from the environment: every ./assets/en_us_<patch>.json
+// file is loaded for each set in DATA_SETS (comma separated mutators, default "TFTSet14").
+// DEFAULT_SET and DEFAULT_PATCH pick the data used when a request does not choose (default
+// TFTSet14 on patch 14.5). BALANCE_OVERRIDES names an optional overrides JSON file applied
+// to every matching set.
+func dataSource() data.FileSource {
+	src := data.FileSource{
+		Dir:           "./assets",
+		SetIDs:        []string{"TFTSet14"},
+		DefaultSet:    os.Getenv("DEFAULT_SET"),
+		DefaultPatch:  os.Getenv("DEFAULT_PATCH"),
+		OverridesFile: os.Getenv("BALANCE_OVERRIDES"),
+	}
+	if raw := os.Getenv("DATA_SETS"); raw != "" {
+		src.SetIDs = strings.Split(raw, ",")
+	}
+	if src.DefaultSet == "" {
+		src.DefaultSet = "TFTSet14"
+	}
+	if src.DefaultPatch == "" {
+		src.DefaultPatch = "14.5"
+	}
+	return src
+}
+
+// dataWatchInterval reads DATA_WATCH_INTERVAL (a duration, default 10s; 0 disables watching).
+func dataWatchInterval() time.Duration {
+	raw := os.Getenv("DATA_WATCH_INTERVAL")
+	if raw == "" {
+		return 10 * time.Second
+	}
+	interval, err := time.ParseDuration(raw)
+	if err != nil {
+		log.Printf("Invalid DATA_WATCH_INTERVAL %q, data watching disabled", raw)
+		return 0
+	}
+	return interval
+}
+
 func main() {
 	// 1. Load Game Data
 	log.Println("Loading game data...")
-	dataDir := "./assets"
-	fileName := "en_us_14.5.json"
-	filePath := filepath.Join(dataDir, fileName)
-	tftData, err := data.LoadSetDataFromFile(filePath, "TFTSet14")
+	source := dataSource()
+	registry, err := source.Load()
 	if err != nil {
 		log.Printf("Error loading set data: %v\n", err)
 		os.Exit(1)
 	}
-	data.InitializeChampions(tftData)
-
-	data.InitializeTraits(tftData)
-	data.InitializeSetActiveAugments(tftData, filePath)
-
-	data.InitializeSetActiveItems(tftData, filePath)
-
 
 	// 2. Initialize Services
-	simService := service.NewSimulationService(tftData)
+	simService := service.NewSimulationService(registry)
+	simService.SetResultCache(newResultCache())
 
 	// 3. Initialize Server with Services
 	server := server.New(simService) // Pass simService to New
+	server.SetBoardRepository(newBoardRepository())
+
+	// 4. Reload game data on file changes and from the admin endpoint (ADMIN_TOKEN)
+	reloader := service.NewDataReloader(simService, source)
+	server.SetDataReloader(reloader, os.Getenv("ADMIN_TOKEN"))
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	if interval := dataWatchInterval(); interval > 0 {
+		log.Printf("Watching %s for data changes every %s", source.Dir, interval)
+		go reloader.Watch(watchCtx, interval)
+	}
 
 	// Create a done channel to signal when the shutdown is complete
 	done := make(chan bool, 1)